@@ -1,6 +1,6 @@
 module github.com/reedom/convergen
 
-go 1.19
+go 1.22
 
 require (
 	github.com/google/go-cmp v0.5.9
@@ -8,6 +8,8 @@ require (
 	github.com/stretchr/testify v1.9.0
 	go.lixinio.com/apis v0.336.2
 	golang.org/x/tools v0.17.0
+	google.golang.org/protobuf v1.34.2
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -17,6 +19,4 @@ require (
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	golang.org/x/mod v0.14.0 // indirect
 	google.golang.org/genproto v0.0.0-20240730163845-b1a4ccb954bf // indirect
-	google.golang.org/protobuf v1.34.2 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
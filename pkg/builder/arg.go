@@ -0,0 +1,67 @@
+package builder
+
+import (
+	"errors"
+	"fmt"
+	"go/types"
+	"strings"
+)
+
+// funcArg is an argument of the generated function that converters and manipulators can receive.
+type funcArg struct {
+	name string     // The name of the argument.
+	typ  types.Type // The type of the argument.
+}
+
+// errAmbiguousArgs is the error that more than one argument fits a parameter.
+var errAmbiguousArgs = errors.New("ambiguous args")
+
+// matchArgs finds the arguments to pass to the given parameters.
+// It returns an error if any of the parameters has no argument to receive, or more than one.
+func matchArgs(params []*types.Var, args []funcArg) ([]string, error) {
+	exprs := make([]string, 0, len(params))
+	for _, param := range params {
+		expr, err := matchArg(param, args)
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, expr)
+	}
+	return exprs, nil
+}
+
+// matchArg finds the argument to pass to the parameter.
+// An argument of the identical type is preferred over an assignable one. Where more than one argument
+// of the type fit, the one of the same name as the parameter is passed; otherwise it is ambiguous.
+func matchArg(param *types.Var, args []funcArg) (string, error) {
+	for _, fits := range []func(t, param types.Type) bool{types.Identical, types.AssignableTo} {
+		var candidates []string
+		for _, arg := range args {
+			if fits(arg.typ, param.Type()) {
+				candidates = append(candidates, arg.name)
+			}
+		}
+		switch len(candidates) {
+		case 0:
+			continue
+		case 1:
+			return candidates[0], nil
+		}
+		for _, name := range candidates {
+			if name == param.Name() {
+				return name, nil
+			}
+		}
+		return "", fmt.Errorf("%w %v for param %v %v, name one of them %v",
+			errAmbiguousArgs, strings.Join(candidates, ", "), paramName(param), param.Type(), paramName(param))
+	}
+	return "", fmt.Errorf("no arg for param %v %v", paramName(param), param.Type())
+}
+
+// paramName returns the name of the parameter, or "_" if it has none.
+func paramName(param *types.Var) string {
+	if param.Name() == "" {
+		return "_"
+	}
+	return param.Name()
+}
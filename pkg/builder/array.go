@@ -0,0 +1,60 @@
+package builder
+
+import (
+	"go/types"
+
+	bmodel "github.com/reedom/convergen/pkg/builder/model"
+	gmodel "github.com/reedom/convergen/pkg/generator/model"
+	"github.com/reedom/convergen/pkg/logger"
+	"github.com/reedom/convergen/pkg/util"
+)
+
+// arrayCopy attempts to create an assignment between arrays, or between an array and a slice,
+// converting the elements as needed. It returns nil if they cannot be copied.
+// A slice is copied to an array only if the method returns an error, to report a slice of
+// a different length than the array.
+func (b *assignmentBuilder) arrayCopy(lhs, rhs bmodel.Node) (gmodel.Assignment, error) {
+	lhsElem, lhsLen, lhsArray := sequenceElem(lhs.ExprType())
+	rhsElem, rhsLen, rhsArray := sequenceElem(rhs.ExprType())
+	if lhsElem == nil || rhsElem == nil || (!lhsArray && !rhsArray) {
+		return nil, nil
+	}
+	if lhsArray && rhsArray && lhsLen < rhsLen {
+		return nil, nil
+	}
+
+	checkLen := lhsArray && !rhsArray
+	if checkLen && !b.retError {
+		logger.Warnf("%v: copying %v to the array %v needs the method to return an error for the length check",
+			b.fset.Position(b.methodPos), rhs.AssignExpr(), lhs.AssignExpr())
+		return nil, nil
+	}
+
+	elem, ok, err := b.elemNode(lhsElem, bmodel.NewScalarNode(nil, "e", rhsElem), lhs.MatcherExpr()+"[]")
+	if !ok || err != nil {
+		return nil, err
+	}
+
+	a := gmodel.ArrayAssignment{
+		LHS:       lhs.AssignExpr(),
+		RHS:       rhs.AssignExpr(),
+		Elem:      elem.AssignExpr(),
+		ElemError: elem.ReturnsError(),
+		CheckLen:  checkLen,
+	}
+	if !lhsArray {
+		a.Typ = b.imports.TypeName(lhs.ExprType())
+	}
+	return a, nil
+}
+
+// sequenceElem returns the element type of t if t is an array or a slice, along with the length of an array.
+func sequenceElem(t types.Type) (elem types.Type, length int64, isArray bool) {
+	if array := util.ArrayType(t); array != nil {
+		return array.Elem(), array.Len(), true
+	}
+	if slice, ok := t.Underlying().(*types.Slice); ok {
+		return slice.Elem(), 0, false
+	}
+	return nil, 0, false
+}
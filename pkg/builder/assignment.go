@@ -1,10 +1,12 @@
 package builder
 
 import (
+	"errors"
 	"fmt"
 	"go/ast"
 	"go/token"
 	"go/types"
+	"strings"
 
 	bmodel "github.com/reedom/convergen/pkg/builder/model"
 	gmodel "github.com/reedom/convergen/pkg/generator/model"
@@ -22,17 +24,27 @@ type assignmentBuilder struct {
 	pkg     *packages.Package // The package the assignment belongs to.
 	imports util.ImportNames  // The import names to use in the generated code.
 
-	methodPos token.Pos      // The position of the method in the source code.
-	opts      option.Options // The options to use when generating the code.
-	lhsVar    gmodel.Var     // The variable on the left-hand side of the assignment.
-	rhsVar    gmodel.Var     // The variable on the right-hand side of the assignment.
-
-	funcName string           // The name of the method being generated.
-	copiers  []*bmodel.Copier // The list of copiers used in the generated code.
+	methodPos token.Pos             // The position of the method in the source code.
+	opts      option.Options        // The options to use when generating the code.
+	lhsVar    gmodel.Var            // The variable on the left-hand side of the assignment.
+	rhsVars   []gmodel.Var          // The variables on the right-hand side of the assignment. The first one is the primary.
+	rhsRoots  []bmodel.Node         // The root nodes of rhsVars in the order of precedence.
+	retError  bool                  // Whether the method being generated returns an error.
+	args      []funcArg             // The arguments of the method being generated, which converters can receive.
+	siblings  []*bmodel.MethodEntry // The methods being generated together, which can convert elements.
+	owner     *FunctionBuilder      // The builder that holds the helper functions for nested structs.
+
+	funcName string                              // The name of the method being generated.
+	copiers  []*bmodel.Copier                    // The list of copiers used in the generated code.
+	inlined  []*bmodel.Copier                    // The nested structs being copied inline, to detect recursion.
+	consumed map[string]struct{}                 // The source fields consumed by the assignments, for :exhaustive:src.
+	oneofs   map[string]*gmodel.SwitchAssignment // The type switches on the oneof fields of the sources, by the fields.
 }
 
 // newAssignmentBuilder creates a new assignmentBuilder instance.
-func newAssignmentBuilder(p *FunctionBuilder, m *bmodel.MethodEntry, lhsVar, rhsVar gmodel.Var) *assignmentBuilder {
+func newAssignmentBuilder(
+	p *FunctionBuilder, m *bmodel.MethodEntry, args []funcArg, lhsVar gmodel.Var, rhsVars ...gmodel.Var,
+) *assignmentBuilder {
 	return &assignmentBuilder{
 		file:      p.file,
 		fset:      p.fset,
@@ -41,16 +53,23 @@ func newAssignmentBuilder(p *FunctionBuilder, m *bmodel.MethodEntry, lhsVar, rhs
 		methodPos: m.Method.Pos(),
 		opts:      m.Opts,
 		lhsVar:    lhsVar,
-		rhsVar:    rhsVar,
+		rhsVars:   rhsVars,
+		retError:  m.RetError(),
+		args:      args,
+		siblings:  p.methods,
+		owner:     p,
 		funcName:  m.Name(),
+		consumed:  map[string]struct{}{},
+		oneofs:    map[string]*gmodel.SwitchAssignment{},
 	}
 }
 
 // build generates the code for the assignment.
-func (b *assignmentBuilder) build(lhs, rhs *types.Var, retError bool) (
+// rhs holds the source variables that correspond to rhsVars.
+func (b *assignmentBuilder) build(lhs *types.Var, rhs []*types.Var, retError bool) (
 	[]gmodel.Assignment, gmodel.Assignment, error,
 ) {
-	rootCopier := bmodel.NewCopier("", lhs.Type(), rhs.Type())
+	rootCopier := bmodel.NewCopier("", lhs.Type(), rhs[0].Type())
 	rootCopier.IsRoot = true
 	if b.opts.Receiver != "" {
 		rootCopier.Name = fmt.Sprintf("%v.%v", b.lhsVar.Name, b.funcName)
@@ -60,8 +79,114 @@ func (b *assignmentBuilder) build(lhs, rhs *types.Var, retError bool) (
 	b.copiers = append(b.copiers, rootCopier)
 
 	rootLHS := bmodel.NewRootNode(b.lhsVar.Name, lhs.Type())
-	rootRHS := bmodel.NewRootNode(b.rhsVar.Name, rhs.Type())
-	return b.dispatch(rootLHS, rootRHS, retError)
+	roots := make([]bmodel.Node, len(rhs))
+	for i := range rhs {
+		roots[i] = bmodel.NewRootNode(b.rhsVars[i].Name, rhs[i].Type())
+	}
+
+	var err error
+	b.rhsRoots, err = b.orderByPrecedence(roots)
+	if err != nil {
+		return nil, nil, err
+	}
+	b.dropInapplicableRules(rootLHS, roots[0])
+	assignments, postAssignment, err := b.dispatch(rootLHS, roots[0], retError)
+	if err == nil && b.opts.ExhaustiveSrc {
+		err = b.reportUnusedSources()
+	}
+	return assignments, postAssignment, err
+}
+
+// dropInapplicableRules removes the mapping rules inherited from the interface whose
+// destination or source path doesn't exist in the method's types, warning about each.
+// The rules declared on the method itself are kept as they are.
+func (b *assignmentBuilder) dropInapplicableRules(lhs, rhs bmodel.Node) {
+	if len(b.opts.InheritedRules) == 0 {
+		return
+	}
+
+	applies := func(pos token.Pos, notation string, dst, src *option.IdentMatcher) bool {
+		if !b.opts.IsInherited(pos) {
+			return true
+		}
+		if _, ok := b.resolveExpr(trimSliceMatcher(dst), lhs); !ok {
+			logger.Warnf("%v: :%v for %v doesn't apply to %v, ignored",
+				b.fset.Position(pos), notation, dst.Pattern(), b.funcName)
+			return false
+		}
+		if src == nil {
+			return true
+		}
+		if _, ok := b.resolveExpr(trimSliceMatcher(src), rhs); !ok {
+			logger.Warnf("%v: :%v for %v doesn't apply to %v, ignored",
+				b.fset.Position(pos), notation, src.Pattern(), b.funcName)
+			return false
+		}
+		return true
+	}
+
+	var mappers []*option.NameMatcher
+	for _, m := range b.opts.NameMapper {
+		if applies(m.Pos(), "map", m.Dst(), m.Src()) {
+			mappers = append(mappers, m)
+		}
+	}
+	var converters []*option.FieldConverter
+	for _, c := range b.opts.Converters {
+		if applies(c.Pos(), "conv", c.Dst(), c.Src()) {
+			converters = append(converters, c)
+		}
+	}
+	var methods []*option.FieldConverter
+	for _, m := range b.opts.Methods {
+		if applies(m.Pos(), "method", m.Dst(), m.Src()) {
+			methods = append(methods, m)
+		}
+	}
+	var literals []*option.LiteralSetter
+	for _, l := range b.opts.Literals {
+		if applies(l.Pos(), "literal", l.Dst(), nil) {
+			literals = append(literals, l)
+		}
+	}
+	b.opts.NameMapper, b.opts.Converters, b.opts.Methods, b.opts.Literals = mappers, converters, methods, literals
+}
+
+// trimSliceMatcher returns a matcher without the trailing "[]" or "[key]" of an element pattern of a slice or a map.
+func trimSliceMatcher(m *option.IdentMatcher) *option.IdentMatcher {
+	for _, suffix := range []string{"[]", mapKeySuffix} {
+		if strings.HasSuffix(m.Pattern(), suffix) {
+			return option.NewIdentMatcher(strings.TrimSuffix(m.Pattern(), suffix))
+		}
+	}
+	return m
+}
+
+// orderByPrecedence sorts the source root nodes as the precedence option lists.
+// Sources that are not listed follow in the order of the arguments.
+func (b *assignmentBuilder) orderByPrecedence(roots []bmodel.Node) ([]bmodel.Node, error) {
+	ordered := make([]bmodel.Node, 0, len(roots))
+	used := make([]bool, len(roots))
+	for _, name := range b.opts.Precedence {
+		found := false
+		for i, root := range roots {
+			if root.AssignExpr() == name && !used[i] {
+				ordered = append(ordered, root)
+				used[i] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, logger.Errorf("%v: unknown source %v in :precedence", b.fset.Position(b.methodPos), name)
+		}
+	}
+	for i, root := range roots {
+		if !used[i] {
+			ordered = append(ordered, root)
+		}
+	}
+	return ordered, nil
 }
 
 // dispatch decides what type of assignment should be generated.
@@ -94,18 +219,24 @@ func (b *assignmentBuilder) structToStruct(lhsStruct, rhsStruct bmodel.Node, ret
 
 		var a gmodel.Assignment
 		a, err = b.matchStructFieldAndStruct(lhsField, rhsStruct)
-		if err == nil && a != nil {
+		if err != nil {
+			return true
+		}
+		if a != nil {
 			assignments = append(assignments, a)
 		}
 		return
 	})
+	if err != nil {
+		return nil, nil, err
+	}
 
 	postAssignment, err := b.buildPostAssignment(lhsStruct, rhsStruct, retError)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	return assignments, postAssignment, err
+	return assignments, postAssignment, nil
 }
 
 // matchStructFieldAndStruct matches a field in a struct with another struct
@@ -121,6 +252,12 @@ func (b *assignmentBuilder) matchStructFieldAndStruct(
 		return gmodel.SkipField{LHS: lhs.AssignExpr()}, nil
 	}
 
+	tag := fieldDirectives(lhs)
+	if tag.Skip {
+		logger.Printf("%v: skip %v by the field tag", b.fset.Position(b.methodPos), lhs.AssignExpr())
+		return gmodel.SkipField{LHS: lhs.AssignExpr()}, nil
+	}
+
 	for _, converter := range b.opts.Converters {
 		if converter.Dst().Match(lhs.MatcherExpr(), true) {
 			// If there are more than one converter exist for the lhs, the first one wins.
@@ -128,6 +265,13 @@ func (b *assignmentBuilder) matchStructFieldAndStruct(
 		}
 	}
 
+	for _, converter := range b.opts.StructConverters {
+		if converter.Dst().Match(lhs.MatcherExpr(), true) {
+			// If there are more than one converter exist for the lhs, the first one wins.
+			return b.createWithStructConverter(lhs, rhs, converter)
+		}
+	}
+
 	for _, method := range b.opts.Methods {
 		if method.Dst().Match(lhs.MatcherExpr(), true) {
 			// If there are more than one converter exist for the lhs, the first one wins.
@@ -149,6 +293,10 @@ func (b *assignmentBuilder) matchStructFieldAndStruct(
 		}
 	}
 
+	if tag.Name != "" || tag.Conv != "" {
+		return b.createWithFieldTag(lhs, rhs, tag)
+	}
+
 	for _, converter := range b.opts.ParseMaskConverters {
 		if converter.Dst().Match(lhs.MatcherExpr(), true) {
 			// If there are more than one mapper exist for the lhs, the first one wins.
@@ -188,20 +336,111 @@ func (b *assignmentBuilder) matchStructFieldAndStruct(
 // If no match is found, returns a NoMatchField or SkipField if the field is to be skipped
 // based on the options set in the AssignmentBuilder.
 func (b *assignmentBuilder) structFieldAndStructGettersAndFields(lhs bmodel.Node, rhsStruct bmodel.Node) (gmodel.Assignment, error) {
+	methodPosStr := b.fset.Position(b.methodPos)
+	lhsExpr := lhs.AssignExpr()
+
+	rhsStructs := []bmodel.Node{rhsStruct}
+	if rhsStruct.Parent() == nil {
+		// Top-level fields can be copied from any of the sources.
+		rhsStructs = b.rhsRoots
+	}
+
+	if len(b.opts.RenameRules) > 0 {
+		// Renaming rules take precedence over the plain name matching.
+		a, nested, err := b.lookupSources(lhs, rhsStructs, b.compareRenamed)
+		if a != nil || err != nil || nested {
+			return a, err
+		}
+	}
+
+	a, nested, err := b.lookupSources(lhs, rhsStructs, b.compareFields)
+	if a != nil || err != nil || nested {
+		return a, err
+	}
+
+	if b.opts.Proto {
+		var ok bool
+		if isOneofField(lhs) {
+			a, ok, err = b.fieldToOneof(lhs, rhsStructs)
+		} else {
+			a, ok, err = b.oneofToField(lhs, rhsStructs)
+		}
+		if ok || err != nil {
+			return a, err
+		}
+	}
+
+	logger.Warnf("%v: no assignment for %v [%v]%v",
+		methodPosStr, lhsExpr, b.imports.TypeName(lhs.ExprType()), b.suggestSources(lhs, rhsStructs))
+	return gmodel.NoMatchField{LHS: lhsExpr}, nil
+}
+
+// lookupSources looks up a getter or field that matches lhs by compare in rhsStructs in order.
+// If the match belongs to a source that can be nil at runtime, the rest of rhsStructs serves as a fallback.
+func (b *assignmentBuilder) lookupSources(
+	lhs bmodel.Node, rhsStructs []bmodel.Node, compare func(lhs, rhs bmodel.Node) bool,
+) (gmodel.Assignment, bool, error) {
+	for i, rhsStruct := range rhsStructs {
+		a, nested, err := b.lookupStructGettersAndFields(lhs, rhsStruct, compare)
+		if a == nil || err != nil {
+			if err != nil || nested {
+				return a, nested, err
+			}
+			continue
+		}
+
+		guarded := b.guardSource(a, rhsStruct)
+		if ifAssignment, ok := guarded.(gmodel.IfAssignment); ok {
+			ifAssignment.Else, _, err = b.lookupSources(lhs, rhsStructs[i+1:], compare)
+			return ifAssignment, nested, err
+		}
+		return guarded, nested, nil
+	}
+	return nil, false, nil
+}
+
+// lookupStructGettersAndFields looks up a getter or field of rhsStruct that matches lhs by compare.
+// nested reports that lhs matched a nested struct, even if no assignment is generated for its contents.
+func (b *assignmentBuilder) lookupStructGettersAndFields(
+	lhs bmodel.Node, rhsStruct bmodel.Node, compare func(lhs, rhs bmodel.Node) bool,
+) (
+	a gmodel.Assignment, nested bool, err error,
+) {
 	opts := b.opts
 	methodPosStr := b.fset.Position(b.methodPos)
 	lhsExpr := lhs.AssignExpr()
 
 	logger.Printf("%v: lookup assignment for %v = %v.*", methodPosStr, lhsExpr, rhsStruct.AssignExpr())
 
-	var a gmodel.Assignment
-	var err error
-	// To prevent logging "no assignment for d.NestedData"…
-	nested := false
+	if opts.Rule == gmodel.MatchRuleNormalized {
+		var ok bool
+		if compare, ok = b.disambiguate(lhs, rhsStruct, compare); !ok {
+			return nil, false, nil
+		}
+	}
 
 	handler := func(rhs bmodel.Node) (done bool) {
-		if !b.isStructFieldAccessible(rhsStruct, rhs.ObjName()) ||
-			!opts.CompareFieldName(lhs.ObjName(), rhs.ObjName()) {
+		tag := fieldDirectives(rhs)
+		if tag.Skip ||
+			!b.isStructFieldAccessible(rhsStruct, rhs.ObjName()) ||
+			!compare(lhs, rhs) {
+			return
+		}
+		defer b.consumeIfAssigned(&a, rhs)
+
+		if tag.Conv != "" {
+			var c bmodel.Node
+			if c, err = b.tagConverterNode(lhs.ExprType(), rhs); err != nil {
+				return true
+			}
+			if c != nil {
+				rhsExpr := c.AssignExpr()
+				logger.Printf("%v: assignment found: %v = %v", methodPosStr, lhsExpr, rhsExpr)
+				a = gmodel.SimpleField{LHS: lhsExpr, RHS: rhsExpr, Error: c.ReturnsError()}
+				return true
+			}
+			logger.Warnf("%v: converter in the tag of %v doesn't fit %v [%v]",
+				methodPosStr, rhs.AssignExpr(), lhsExpr, b.imports.TypeName(lhs.ExprType()))
 			return
 		}
 
@@ -213,19 +452,52 @@ func (b *assignmentBuilder) structFieldAndStructGettersAndFields(lhs bmodel.Node
 			}
 		}
 
-		if c, ok := b.castNode(lhs.ExprType(), rhs); ok {
+		if util.MapType(lhs.ExprType()) != nil && util.MapType(rhs.ExprType()) != nil {
+			a, err = b.mapToMap(lhs, rhs)
+			if a != nil || err != nil {
+				logger.Printf("%v: assignment found: mapCopy(%v, %v)", methodPosStr, lhsExpr, rhs.AssignExpr())
+				return true
+			}
+		}
+
+		var c bmodel.Node
+		var ok bool
+		if c, ok, err = b.castNode(lhs.ExprType(), rhs); err != nil {
+			return true
+		}
+		if ok {
 			rhsExpr := c.AssignExpr()
 			logger.Printf("%v: assignment found: %v = %v", methodPosStr, lhsExpr, rhsExpr)
 			a = gmodel.SimpleField{LHS: lhsExpr, RHS: rhsExpr, Error: c.ReturnsError()}
 			return true
 		}
 
-		if util.IsStructType(lhs.ExprType()) &&
-			util.IsStructType(rhs.ExprType()) {
+		if a, err = b.arrayCopy(lhs, rhs); a != nil || err != nil {
+			logger.Printf("%v: assignment found: arrayCopy(%v, %v)", methodPosStr, lhsExpr, rhs.AssignExpr())
+			return true
+		}
+
+		if a, ok, err = b.bridgeAssignment(lhs, rhs, b.castNode); ok || err != nil {
+			logger.Printf("%v: assignment found: %v = %v", methodPosStr, lhsExpr, rhs.AssignExpr())
+			return true
+		}
+
+		// Pointers to structs are copied, too, where either both of them are pointers or :ptr bridges them.
+		if util.IsStructType(util.DerefPtr(lhs.ExprType())) &&
+			util.IsStructType(util.DerefPtr(rhs.ExprType())) &&
+			(util.IsPtr(lhs.ExprType()) == util.IsPtr(rhs.ExprType()) || opts.Ptr) {
 			nested = true
+			if !b.copiesInline(lhs, rhs) {
+				a, err = b.structCopy(lhs, rhs)
+				logger.Printf("%v: assignment found: %v = %v", methodPosStr, lhsExpr, rhs.AssignExpr())
+				return true
+			}
+
+			b.inlined = append(b.inlined, bmodel.NewCopier("", lhs.ExprType(), rhs.ExprType()))
+			defer func() { b.inlined = b.inlined[:len(b.inlined)-1] }()
 			nestStruct := gmodel.NestStruct{}
 			if util.IsPtr(lhs.ExprType()) {
-				nestStruct.InitExpr = fmt.Sprintf("%v = %v{}", lhs.AssignExpr(), b.imports.TypeName(lhs.ExprType()))
+				nestStruct.InitExpr = fmt.Sprintf("%v = &%v{}", lhs.AssignExpr(), b.imports.TypeName(util.DerefPtr(lhs.ExprType())))
 			}
 			if rhs.ObjNullable() {
 				nestStruct.NullCheckExpr = rhs.NullCheckExpr()
@@ -241,24 +513,192 @@ func (b *assignmentBuilder) structFieldAndStructGettersAndFields(lhs bmodel.Node
 	if opts.Getter {
 		bmodel.IterateStructMethods(rhsStruct, handler)
 		if a != nil || err != nil {
-			return a, err
+			return a, false, err
 		}
 	}
 
-	if opts.Rule == gmodel.MatchRuleName {
-		bmodel.IterateStructFields(rhsStruct, handler)
+	if opts.Rule == gmodel.MatchRuleName || opts.Rule == gmodel.MatchRuleTag || opts.Rule == gmodel.MatchRuleNormalized {
+		b.iterateSourceFields(rhsStruct, handler)
 		if a != nil || err != nil || nested {
-			return a, err
+			return a, nested, err
 		}
 	}
+	return nil, false, nil
+}
 
-	logger.Warnf("%v: no assignment for %v [%v]", methodPosStr, lhsExpr, b.imports.TypeName(lhs.ExprType()))
-	return gmodel.NoMatchField{LHS: lhsExpr}, nil
+// disambiguate narrows compare down to a single member of rhsStruct when more than one of them match lhs
+// under the normalized matching rule, like "UserID" and "user_id" do. The one whose name equals the name of lhs
+// wins. Otherwise it reports the ambiguity and returns false.
+func (b *assignmentBuilder) disambiguate(
+	lhs, rhsStruct bmodel.Node, compare func(lhs, rhs bmodel.Node) bool,
+) (func(lhs, rhs bmodel.Node) bool, bool) {
+	var candidates []string
+	collect := func(rhs bmodel.Node) (done bool) {
+		if !fieldDirectives(rhs).Skip &&
+			b.isStructFieldAccessible(rhsStruct, rhs.ObjName()) &&
+			compare(lhs, rhs) {
+			candidates = append(candidates, rhs.ObjName())
+		}
+		return
+	}
+	if b.opts.Getter {
+		bmodel.IterateStructMethods(rhsStruct, collect)
+	}
+	b.iterateSourceFields(rhsStruct, collect)
+
+	if len(candidates) < 2 {
+		return compare, true
+	}
+	for _, name := range candidates {
+		if name == lhs.ObjName() {
+			return func(lhs, rhs bmodel.Node) bool { return rhs.ObjName() == name }, true
+		}
+	}
+	logger.Warnf("%v: %v is ambiguous among %v.{%v}, use :map to pick one",
+		b.fset.Position(b.methodPos), lhs.AssignExpr(), rhsStruct.AssignExpr(), strings.Join(candidates, ", "))
+	return nil, false
+}
+
+// guardSource wraps the assignment with a nil check if rhs belongs to a nullable source of more than one.
+// A sole source is checked at the beginning of the function instead, which returns early only if all the
+// sources are nil where there are more than one.
+func (b *assignmentBuilder) guardSource(a gmodel.Assignment, rhs bmodel.Node) gmodel.Assignment {
+	switch a.(type) {
+	case nil, gmodel.NoMatchField, gmodel.SkipField:
+		return a
+	}
+
+	root := rhs
+	for ; root.Parent() != nil; root = root.Parent() {
+	}
+	if _, ok := root.(bmodel.RootNode); !ok || len(b.rhsVars) < 2 || !root.ObjNullable() {
+		return a
+	}
+	return gmodel.IfAssignment{Inner: a, Nullable: true, Expr: root.NullCheckExpr()}
+}
+
+// compareRenamed reports whether the renaming rules turn the name of rhs into the name of lhs.
+// The last rule that matches the name of rhs decides it.
+func (b *assignmentBuilder) compareRenamed(lhs, rhs bmodel.Node) bool {
+	for i := len(b.opts.RenameRules) - 1; 0 <= i; i-- {
+		if renamed, ok := b.opts.RenameRules[i].Rename(rhs.ObjName()); ok {
+			return b.opts.CompareFieldName(lhs.ObjName(), renamed)
+		}
+	}
+	return false
+}
+
+// compareFields reports whether the lhs and rhs nodes are paired under the matching rule.
+// With the tag rule, fields are paired by their struct tag values. If either of them lacks
+// the tag, they are compared by name only when the fallback is enabled.
+func (b *assignmentBuilder) compareFields(lhs, rhs bmodel.Node) bool {
+	if b.opts.Rule == gmodel.MatchRuleTag {
+		lhsTag, lhsOk := fieldTag(lhs, b.opts.TagKey())
+		rhsTag, rhsOk := fieldTag(rhs, b.opts.TagKey())
+		if lhsOk && rhsOk {
+			return lhsTag == rhsTag
+		}
+		if !b.opts.MatchTagFallback {
+			return false
+		}
+	}
+	rhsName := rhs.ObjName()
+	if tag := fieldDirectives(rhs); tag.Name != "" {
+		rhsName = tag.Name
+	}
+	return b.opts.CompareFieldName(lhs.ObjName(), rhsName)
+}
+
+// fieldTag returns the struct tag value of the node if it represents a struct field.
+func fieldTag(node bmodel.Node, key string) (string, bool) {
+	field, ok := node.(bmodel.StructFieldNode)
+	if !ok {
+		return "", false
+	}
+	return field.Tag(key)
+}
+
+// fieldDirectives returns the directives in the `convergen` struct tag of node if it is a struct field.
+func fieldDirectives(node bmodel.Node) option.FieldTag {
+	field, ok := node.(bmodel.StructFieldNode)
+	if !ok {
+		return option.FieldTag{}
+	}
+	return field.Directives()
+}
+
+// tagConverterNode applies the converter in the `convergen` struct tag of node and casts the result to lhsType.
+// It returns nil if node has no converter or the converter doesn't fit.
+func (b *assignmentBuilder) tagConverterNode(lhsType types.Type, node bmodel.Node) (bmodel.Node, error) {
+	field, ok := node.(bmodel.StructFieldNode)
+	if !ok {
+		return nil, nil
+	}
+	converter, ok := b.opts.TagConverters[field.Field()]
+	if !ok {
+		return nil, nil
+	}
+	return b.convertNode(lhsType, node, converter)
+}
+
+// createWithFieldTag creates an assignment for lhs by the `name=` and `conv=` directives in its struct tag.
+// The source field is looked up in rhs by the name in the tag, or by the name of lhs.
+func (b *assignmentBuilder) createWithFieldTag(lhs, rhs bmodel.Node, tag option.FieldTag) (a gmodel.Assignment, err error) {
+	field := lhs.(bmodel.StructFieldNode).Field()
+	lhsExpr := lhs.AssignExpr()
+	posStr := b.fset.Position(field.Pos())
+
+	name := tag.Name
+	if name == "" {
+		name = lhs.ObjName()
+	}
+	rhsNode, ok := b.resolveExpr(option.NewIdentMatcher(name), rhs)
+	if !ok {
+		logger.Warnf("%v: no source %v for %v", posStr, name, lhsExpr)
+		return gmodel.NoMatchField{LHS: lhsExpr}, nil
+	}
+	defer b.consumeIfAssigned(&a, rhsNode)
+
+	var node bmodel.Node
+	var cast castFunc
+	if tag.Conv != "" {
+		if converter, ok := b.opts.TagConverters[field]; ok {
+			node, err = b.convertNode(lhs.ExprType(), rhsNode, converter)
+			cast = b.converterCast(converter)
+		}
+	} else {
+		node, _, err = b.castNode(lhs.ExprType(), rhsNode)
+		cast = b.castNode
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if node == nil && cast != nil {
+		a, ok, err := b.bridgeAssignment(lhs, rhsNode, cast)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			logger.Printf("%v: assignment found: %v = %v", posStr, lhsExpr, rhsNode.AssignExpr())
+			return b.guardSource(a, rhsNode), nil
+		}
+	}
+	if node == nil {
+		logger.Warnf("%v: no assignment for %v [%v]", posStr, lhsExpr, b.imports.TypeName(lhs.ExprType()))
+		return gmodel.NoMatchField{LHS: lhsExpr}, nil
+	}
+
+	rhsExpr := node.AssignExpr()
+	logger.Printf("%v: assignment found: %v = %v", posStr, lhsExpr, rhsExpr)
+	a = gmodel.SimpleField{LHS: lhsExpr, RHS: rhsExpr, Error: node.ReturnsError()}
+	return b.guardSource(a, rhsNode), nil
 }
 
 func (b *assignmentBuilder) createWithParseMask(
 	lhs, rhs bmodel.Node, mapper *option.MaskConverter,
 ) (gmodel.Assignment, error) {
+	var srcNode bmodel.Node
 	mappedNode := func() bmodel.Node {
 		root := rhs
 		for ; root.Parent() != nil; root = root.Parent() {
@@ -269,6 +709,7 @@ func (b *assignmentBuilder) createWithParseMask(
 		if !ok {
 			return nil
 		}
+		srcNode = rhsNode
 
 		lhsExpr := rhsNode.AssignExpr()
 		posStr := b.fset.Position(mapper.Pos())
@@ -303,6 +744,7 @@ func (b *assignmentBuilder) createWithParseMask(
 	if mappedNode != nil {
 		rhsExpr := mappedNode.AssignExpr()
 		logger.Printf("%v: assignment found: %v = %v", posStr, lhs, rhs)
+		b.consume(srcNode)
 		return gmodel.RawAssignment{Raw: rhsExpr, Err: false}, nil
 	}
 
@@ -321,6 +763,7 @@ func (b *assignmentBuilder) createWithBuildMaskIngore(
 func (b *assignmentBuilder) createWithBuildMask(
 	lhs, rhs bmodel.Node, mapper *option.MaskConverter,
 ) (gmodel.Assignment, error) {
+	var srcNode bmodel.Node
 	mappedNode := func() bmodel.Node {
 		root := rhs
 		for ; root.Parent() != nil; root = root.Parent() {
@@ -331,6 +774,7 @@ func (b *assignmentBuilder) createWithBuildMask(
 		if !ok {
 			return nil
 		}
+		srcNode = rhsNode
 
 		lhsExpr := rhsNode.AssignExpr()
 		posStr := b.fset.Position(mapper.Pos())
@@ -364,6 +808,7 @@ func (b *assignmentBuilder) createWithBuildMask(
 	if mappedNode != nil {
 		rhsExpr := mappedNode.AssignExpr()
 		logger.Printf("%v: assignment found: %v = %v", posStr, lhs, rhs)
+		b.consume(srcNode)
 		return gmodel.RawAssignment{Err: false, Raw: rhsExpr}, nil
 	}
 
@@ -373,39 +818,97 @@ func (b *assignmentBuilder) createWithBuildMask(
 
 // createWithConverter creates an assignment using the given field converter.
 // It resolves the source field, applies the converter, and creates an assignment from the result.
-func (b *assignmentBuilder) createWithConverter(lhs, rhs bmodel.Node, converter *option.FieldConverter) (gmodel.Assignment, error) {
-	converterNode := func() bmodel.Node {
-		root := rhs
-		for ; root.Parent() != nil; root = root.Parent() {
-		}
+func (b *assignmentBuilder) createWithConverter(lhs, rhs bmodel.Node, converter *option.FieldConverter) (a gmodel.Assignment, err error) {
+	root := rhs
+	for ; root.Parent() != nil; root = root.Parent() {
+	}
 
-		rhsNode, ok := b.resolveExpr(converter.Src(), root)
-		if !ok {
-			return nil
+	lhsExpr := lhs.AssignExpr()
+	posStr := b.fset.Position(converter.Pos())
+
+	if rhsNode, ok := b.resolveExpr(converter.Src(), root); ok {
+		defer b.consumeIfAssigned(&a, rhsNode)
+		converterNode, err := b.convertNode(lhs.ExprType(), rhsNode, converter)
+		if err != nil {
+			return nil, err
 		}
+		if converterNode != nil {
+			rhsExpr := converterNode.AssignExpr()
+			logger.Printf("%v: assignment found: %v = %v, err", posStr, lhsExpr, rhsExpr)
+			a := gmodel.SimpleField{LHS: lhsExpr, RHS: rhsExpr, Error: converter.RetError()}
+			return b.guardSource(a, converterNode), nil
+		}
+		a, ok, err := b.bridgeAssignment(lhs, rhsNode, b.converterCast(converter))
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			logger.Printf("%v: assignment found: %v = %v(%v)", posStr, lhsExpr, converter.Converter(), rhsNode.AssignExpr())
+			return b.guardSource(a, rhsNode), nil
+		}
+	}
 
-		argNode, ok := b.castNode(converter.ArgType(), rhsNode)
-		if !ok {
-			if !util.IsPtr(converter.ArgType()) {
-				return nil
-			}
-			argNode, ok = b.castNode(util.DerefPtr(converter.ArgType()), rhsNode)
-			if !ok {
-				return nil
-			}
+	logger.Warnf("%v: no assignment for %v [%v]", posStr, lhsExpr, b.imports.TypeName(lhs.ExprType()))
+	return gmodel.NoMatchField{LHS: lhsExpr}, nil
+}
+
+// convertNode applies the converter to rhs and casts the result to lhsType.
+// It returns nil if either rhs doesn't fit the converter argument or the result doesn't fit lhsType.
+func (b *assignmentBuilder) convertNode(
+	lhsType types.Type, rhs bmodel.Node, converter *option.FieldConverter,
+) (bmodel.Node, error) {
+	argNode, ok, err := b.castNode(converter.ArgType(), rhs)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		if !util.IsPtr(converter.ArgType()) {
+			return nil, nil
 		}
-		convNode := bmodel.NewConverterNode(argNode, converter)
-		casted, _ := b.castNode(lhs.ExprType(), convNode)
-		return casted
-	}()
+		argNode, ok, err = b.castNode(util.DerefPtr(converter.ArgType()), rhs)
+		if !ok || err != nil {
+			return nil, err
+		}
+	}
+	convNode, ok, err := b.newConverterNode(argNode, converter)
+	if !ok || err != nil {
+		return nil, err
+	}
+	casted, _, err := b.castNode(lhsType, convNode)
+	return casted, err
+}
+
+// createWithStructConverter creates an assignment using the given struct-to-field converter.
+// The converter receives the source variable as a whole, either as a value or a pointer.
+func (b *assignmentBuilder) createWithStructConverter(lhs, rhs bmodel.Node, converter *option.FieldConverter) (gmodel.Assignment, error) {
+	root := rhs
+	for ; root.Parent() != nil; root = root.Parent() {
+	}
 
 	lhsExpr := lhs.AssignExpr()
 	posStr := b.fset.Position(converter.Pos())
 
-	if converterNode != nil {
-		rhsExpr := converterNode.AssignExpr()
-		logger.Printf("%v: assignment found: %v = %v, err", posStr, lhsExpr, rhsExpr)
-		return gmodel.SimpleField{LHS: lhsExpr, RHS: rhsExpr, Error: converter.RetError()}, nil
+	rootType := root.ExprType()
+	if types.AssignableTo(rootType, converter.ArgType()) ||
+		types.AssignableTo(rootType, util.DerefPtr(converter.ArgType())) ||
+		types.AssignableTo(util.DerefPtr(rootType), converter.ArgType()) {
+		convNode, ok, err := b.newConverterNode(root, converter)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			casted, ok, err := b.castNode(lhs.ExprType(), convNode)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				b.consumeAll(root)
+				rhsExpr := casted.AssignExpr()
+				logger.Printf("%v: assignment found: %v = %v", posStr, lhsExpr, rhsExpr)
+				a := gmodel.SimpleField{LHS: lhsExpr, RHS: rhsExpr, Error: converter.RetError()}
+				return b.guardSource(a, root), nil
+			}
+		}
 	}
 
 	logger.Warnf("%v: no assignment for %v [%v]", posStr, lhsExpr, b.imports.TypeName(lhs.ExprType()))
@@ -433,11 +936,13 @@ func (b *assignmentBuilder) createWithMethodCall(lhs, rhs bmodel.Node, converter
 	if methodCallNode != nil {
 		rhsExpr := methodCallNode.AssignExpr()
 		logger.Printf("%v: assignment found: %v = %v, err", posStr, lhsExpr, rhsExpr)
-		return gmodel.IfAssignment{
+		a := gmodel.IfAssignment{
 			Inner:    gmodel.SimpleField{LHS: lhsExpr, RHS: rhsExpr, Error: converter.RetError()},
 			Expr:     originNode.AssignExpr(),
 			Nullable: methodCallNode.ObjNullable(),
-		}, nil
+		}
+		b.consume(originNode)
+		return b.guardSource(a, originNode), nil
 	}
 
 	logger.Warnf("%v: no assignment for %v [%v]", posStr, lhsExpr, b.imports.TypeName(lhs.ExprType()))
@@ -450,28 +955,34 @@ func (b *assignmentBuilder) createWithMethodCall(lhs, rhs bmodel.Node, converter
 // If a match is found, it returns a SimpleField with the lhs and rhs expressions and
 // the returns error flag.
 // If a match is not found, it returns a NoMatchField with the lhs expression.
-func (b *assignmentBuilder) createWithMapper(lhs, rhs bmodel.Node, mapper *option.NameMatcher) (gmodel.Assignment, error) {
-	mappedNode := func() bmodel.Node {
-		root := rhs
-		for ; root.Parent() != nil; root = root.Parent() {
-		}
-
-		rhsNode, ok := b.resolveExpr(mapper.Src(), root)
-		if !ok {
-			return nil
-		}
-
-		casted, _ := b.castNode(lhs.ExprType(), rhsNode)
-		return casted
-	}()
+func (b *assignmentBuilder) createWithMapper(lhs, rhs bmodel.Node, mapper *option.NameMatcher) (a gmodel.Assignment, err error) {
+	root := rhs
+	for ; root.Parent() != nil; root = root.Parent() {
+	}
 
 	lhsExpr := lhs.AssignExpr()
 	posStr := b.fset.Position(mapper.Pos())
 
-	if mappedNode != nil {
-		rhsExpr := mappedNode.AssignExpr()
-		logger.Printf("%v: assignment found: %v = %v", posStr, lhs, rhs)
-		return gmodel.SimpleField{LHS: lhsExpr, RHS: rhsExpr, Error: mappedNode.ReturnsError()}, nil
+	if rhsNode, ok := b.resolveExpr(mapper.Src(), root); ok {
+		defer b.consumeIfAssigned(&a, rhsNode)
+		mappedNode, ok, err := b.castNode(lhs.ExprType(), rhsNode)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			rhsExpr := mappedNode.AssignExpr()
+			logger.Printf("%v: assignment found: %v = %v", posStr, lhs, rhs)
+			a := gmodel.SimpleField{LHS: lhsExpr, RHS: rhsExpr, Error: mappedNode.ReturnsError()}
+			return b.guardSource(a, mappedNode), nil
+		}
+		a, ok, err := b.bridgeAssignment(lhs, rhsNode, b.castNode)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			logger.Printf("%v: assignment found: %v = %v", posStr, lhsExpr, rhsNode.AssignExpr())
+			return b.guardSource(a, rhsNode), nil
+		}
 	}
 
 	logger.Warnf("%v: no assignment for %v [%v]", posStr, lhsExpr, b.imports.TypeName(lhs.ExprType()))
@@ -484,18 +995,67 @@ func (b *assignmentBuilder) createWithMapper(lhs, rhs bmodel.Node, mapper *optio
 // If the Stringer option is enabled and the target type is string,
 // and the node type complies with the Stringer interface,
 // it wraps the node in a Stringer node.
+// If a type converter is registered for the node type, it wraps the node in a Converter node.
+// If an :enum:string notation is declared for either type, it converts the node from or to string
+// by the constants of the enum type.
+// If the Enum option is enabled and both types are enum types, it converts the node by pairing
+// their constants by name.
+// If the Time option is enabled and either type is time.Time or time.Duration,
+// it converts the node in the format of the option.
+// If the Proto option is enabled and the target type is a well-known type of protobuf,
+// it creates the message from the node, such as timestamppb.New(src.CreatedAt).
+// If the SQLNull option is enabled and the target type is one of the Null types of database/sql,
+// it wraps the node in a valid Null value.
 // If the Typecast option is enabled and the node type is convertible to the target type,
 // it creates a typecast node and returns it along with true.
 // Otherwise, it returns nil and false.
-func (b *assignmentBuilder) castNode(lhsType types.Type, rhs bmodel.Node) (c bmodel.Node, ok bool) {
+// It returns an error if the conversion the types call for cannot be generated, such as by a converter
+// whose args are ambiguous or between enum types whose constants cannot be paired.
+func (b *assignmentBuilder) castNode(lhsType types.Type, rhs bmodel.Node) (c bmodel.Node, ok bool, err error) {
 	if types.AssignableTo(rhs.ExprType(), lhsType) {
-		return rhs, true
+		return rhs, true, nil
 	}
 
-	if b.opts.Stringer && types.AssignableTo(util.StringType(), lhsType) && util.CompliesStringer(rhs.ExprType()) {
+	if conv := b.lookupTypeConverter(rhs.ExprType(), lhsType); conv != nil {
+		if c, ok, err = b.newConverterNode(rhs, conv.FieldConverter); ok || err != nil {
+			return
+		}
+	}
+
+	if c, ok, err = b.enumStringNode(lhsType, rhs); ok || err != nil {
+		return
+	}
+
+	if b.opts.Enum {
+		if c, ok, err = b.enumNode(lhsType, rhs); ok || err != nil {
+			return
+		}
+	}
+
+	if b.opts.Time != nil {
+		if c, ok, err = b.timeNode(lhsType, rhs); ok || err != nil {
+			return
+		}
+	}
+
+	if b.opts.Proto {
+		if c, ok, err = b.protoNode(lhsType, rhs); ok || err != nil {
+			return
+		}
+	}
+
+	// A pointer is left to ptrAssignment so that String() is called after a nil check.
+	if b.opts.Stringer && types.AssignableTo(util.StringType(), lhsType) && util.CompliesStringer(rhs.ExprType()) &&
+		!(b.opts.Ptr && isValuePtr(rhs.ExprType())) {
 		return b.castNode(lhsType, bmodel.NewStringer(rhs))
 	}
 
+	if b.opts.SQLNull {
+		if c, ok, err = b.sqlNullNode(lhsType, rhs); ok || err != nil {
+			return
+		}
+	}
+
 	if b.opts.Typecast && types.ConvertibleTo(rhs.ExprType(), lhsType) {
 		c, ok = bmodel.NewTypecast(b.pkg.Types.Scope(), b.imports, lhsType, rhs)
 		if !ok {
@@ -504,15 +1064,69 @@ func (b *assignmentBuilder) castNode(lhsType types.Type, rhs bmodel.Node) (c bmo
 		}
 		return
 	}
-	return nil, false
+	return nil, false, nil
+}
+
+// newConverterNode creates a ConverterNode that passes the converter the arguments it asks for.
+// It returns false if the method being generated doesn't have them.
+func (b *assignmentBuilder) newConverterNode(arg bmodel.Node, converter *option.FieldConverter) (bmodel.Node, bool, error) {
+	leading, trailing, ok, err := b.converterArgs(converter)
+	if !ok || err != nil {
+		return nil, false, err
+	}
+	return bmodel.NewConverterNodeWithArgs(arg, converter, leading, trailing), true, nil
+}
+
+// converterArgs finds the arguments of the method being generated to pass to the converter
+// other than the value to convert.
+// Ambiguous args return an error, which fails the generation.
+func (b *assignmentBuilder) converterArgs(converter *option.FieldConverter) (leading, trailing []string, ok bool, err error) {
+	leading, err = matchArgs(converter.LeadingParams(), b.args)
+	if err == nil {
+		trailing, err = matchArgs(converter.TrailingParams(), b.args)
+	}
+	if err == nil {
+		return leading, trailing, true, nil
+	}
+
+	if errors.Is(err, errAmbiguousArgs) {
+		return nil, nil, false,
+			logger.Errorf("%v: converter %v: %v", b.fset.Position(converter.Pos()), converter.Converter(), err)
+	}
+	logger.Warnf("%v: converter %v needs args that the method doesn't have: %v",
+		b.fset.Position(converter.Pos()), converter.Converter(), err)
+	return nil, nil, false, nil
+}
+
+// lookupTypeConverter returns the type converter that converts a value of rhsType to lhsType,
+// or nil if there is none.
+// The last declared one wins so that method-level notations take precedence over interface-level ones.
+func (b *assignmentBuilder) lookupTypeConverter(rhsType, lhsType types.Type) *option.TypeConverter {
+	for i := len(b.opts.TypeConverters) - 1; 0 <= i; i-- {
+		conv := b.opts.TypeConverters[i]
+		if !conv.MatchTypes(rhsType, lhsType) {
+			continue
+		}
+		if conv.RetError() && !b.retError {
+			logger.Warnf("%v: cannot use %v as a type converter since the function does not return an error",
+				b.fset.Position(conv.Pos()), conv.Converter())
+			continue
+		}
+		return conv
+	}
+	return nil
 }
 
 // isStructFieldAccessible returns true if the given struct field is accessible from the current package.
+// In the proto mode, the internal fields of protoc-gen-go messages are not.
 func (b *assignmentBuilder) isStructFieldAccessible(structNode bmodel.Node, leafName string) bool {
 	structType := util.DerefPtr(structNode.ExprType())
 	if !util.IsStructType(structType) {
 		return false
 	}
+	if b.opts.Proto && isProtoInternalField(leafName) {
+		return false
+	}
 	if named, ok := structType.(*types.Named); ok {
 		return !b.isExternalPkg(named.Obj().Pkg()) || ast.IsExported(leafName)
 	}
@@ -528,14 +1142,37 @@ func (b *assignmentBuilder) isExternalPkg(pkg *types.Package) bool {
 	return b.pkg.PkgPath != pkg.Path()
 }
 
+// lookupSource returns the root node of the source variable that the matcher path starts with.
+// Sources are addressed by their names only if the method takes more than one source.
+func (b *assignmentBuilder) lookupSource(matcher *option.IdentMatcher) (bmodel.Node, bool) {
+	if len(b.rhsRoots) < 2 || matcher.ForGetter(0) {
+		return nil, false
+	}
+	for _, root := range b.rhsRoots {
+		if root.AssignExpr() == matcher.ExprAt(0) {
+			return root, true
+		}
+	}
+	return nil, false
+}
+
 // resolveExpr follows the path specified by the IdentMatcher to resolve
 // the corresponding Node in the root node.
 // It returns the resolved node and a boolean indicating whether the
 // resolution was successful.
 func (b *assignmentBuilder) resolveExpr(matcher *option.IdentMatcher, root bmodel.Node) (node bmodel.Node, ok bool) {
 	node = root
-	typ := root.ExprType()
-	for i := 0; i < matcher.PathLen(); i++ {
+	start := 0
+	if src, found := b.lookupSource(matcher); found {
+		node = src
+		start = 1
+		if matcher.PathLen() == start {
+			return node, true
+		}
+	}
+
+	typ := node.ExprType()
+	for i := start; i < matcher.PathLen(); i++ {
 		isLast := matcher.PathLen() == i+1
 		pkg := util.PkgOf(typ)
 
@@ -634,17 +1271,57 @@ func (b *assignmentBuilder) sliceToSlice(lhs, rhs bmodel.Node) (a gmodel.Assignm
 
 	for _, converter := range b.opts.Converters {
 		if converter.Dst().Match(lhs.MatcherExpr()+"[]", true) {
+			leading, trailing, ok, err := b.converterArgs(converter)
+			if !ok || err != nil {
+				return nil, err
+			}
 			a = gmodel.SliceTypecastAssignment{
-				LHS:   lhs.AssignExpr(),
-				RHS:   rhs.AssignExpr(),
-				Typ:   "[]" + b.imports.TypeName(lhsElem),
-				Cast:  converter.Converter(),
-				Error: converter.RetError(),
+				LHS:          lhs.AssignExpr(),
+				RHS:          rhs.AssignExpr(),
+				Typ:          "[]" + b.imports.TypeName(lhsElem),
+				Cast:         converter.Converter(),
+				Error:        converter.RetError(),
+				LeadingArgs:  leading,
+				TrailingArgs: trailing,
 			}
-			return
+			return a, nil
+		}
+	}
+
+	if conv := b.lookupTypeConverter(rhsElem, lhsElem); conv != nil && types.AssignableTo(rhsElem, conv.ArgType()) {
+		leading, trailing, ok, err := b.converterArgs(conv.FieldConverter)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			a = gmodel.SliceTypecastAssignment{
+				LHS:          lhs.AssignExpr(),
+				RHS:          rhs.AssignExpr(),
+				Typ:          "[]" + b.imports.TypeName(lhsElem),
+				Cast:         conv.Converter(),
+				Error:        conv.RetError(),
+				LeadingArgs:  leading,
+				TrailingArgs: trailing,
+			}
+			return a, nil
 		}
 	}
 
+	fn, retErr, ok, err := b.enumFunc(lhsElem, rhsElem)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		a = gmodel.SliceTypecastAssignment{
+			LHS:   lhs.AssignExpr(),
+			RHS:   rhs.AssignExpr(),
+			Typ:   "[]" + b.imports.TypeName(lhsElem),
+			Cast:  fn,
+			Error: retErr,
+		}
+		return
+	}
+
 	if b.opts.Typecast && types.ConvertibleTo(rhsElem, lhsElem) {
 		a = gmodel.SliceTypecastAssignment{
 			LHS:  lhs.AssignExpr(),
@@ -654,5 +1331,6 @@ func (b *assignmentBuilder) sliceToSlice(lhs, rhs bmodel.Node) (a gmodel.Assignm
 		}
 		return
 	}
-	return
+
+	return b.sliceElemCopy(lhs, rhs, lhsElem, rhsElem)
 }
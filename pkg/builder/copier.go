@@ -0,0 +1,318 @@
+package builder
+
+import (
+	"fmt"
+	"go/token"
+	"go/types"
+	"reflect"
+	"strings"
+	"unicode"
+
+	bmodel "github.com/reedom/convergen/pkg/builder/model"
+	gmodel "github.com/reedom/convergen/pkg/generator/model"
+	"github.com/reedom/convergen/pkg/logger"
+	"github.com/reedom/convergen/pkg/option"
+	"github.com/reedom/convergen/pkg/util"
+)
+
+// helper represents an inner function that copies a nested struct.
+// It is shared by every occurrence of the same pair of types built with the same options.
+type helper struct {
+	copier    *bmodel.Copier         // copier describes the pair of types and how the function is built.
+	function  *gmodel.Function       // function is the generated function.
+	converter *option.FieldConverter // converter calls the function.
+	allowErr  bool                   // allowErr is whether the function may return an error, as its callers do.
+	building  bool                   // building is true while the function body is being built.
+	alias     *helper                // alias is the equivalent helper to use instead of this, if any.
+}
+
+// structCopy creates an assignment that copies the nested struct rhs to lhs by calling a helper function.
+// Either or both of them may be pointers; a pointer source is checked against nil and a pointer
+// destination is allocated.
+func (b *assignmentBuilder) structCopy(lhs, rhs bmodel.Node) (gmodel.Assignment, error) {
+	lhsType, lhsPtr := util.Deref(lhs.ExprType())
+	rhsType, rhsPtr := util.Deref(rhs.ExprType())
+	if !b.isNameable(lhsType) || !b.isNameable(rhsType) {
+		logger.Warnf("%v: cannot copy %v to %v recursively, as their types cannot be referred to from here",
+			b.fset.Position(b.methodPos), rhs.AssignExpr(), lhs.AssignExpr())
+		return nil, nil
+	}
+
+	h, err := b.owner.helperFor(b, lhsType, rhsType)
+	if err != nil {
+		return nil, err
+	}
+
+	a := gmodel.PointerAssignment{LHS: lhs.AssignExpr()}
+	arg := rhs
+	if rhsPtr {
+		arg = bmodel.NewDerefNode(rhs)
+		a.NullCheck = rhs.AssignExpr()
+	}
+	if lhsPtr {
+		a.Alloc = b.imports.TypeName(lhsType)
+	}
+	call := bmodel.NewConverterNode(arg, h.converter)
+	a.RHS, a.Error = call.AssignExpr(), call.ReturnsError()
+	if !lhsPtr && !rhsPtr {
+		return gmodel.SimpleField{LHS: a.LHS, RHS: a.RHS, Error: a.Error}, nil
+	}
+	return a, nil
+}
+
+// copiesInline returns true if the nested struct rhs should be copied to lhs field by field in place
+// rather than by a helper function. That is when the method writes into an existing destination,
+// when it has rules addressing fields inside them, when the generated code cannot refer to their types,
+// or when either of them is a protobuf message in the proto mode, which a helper function would copy by value.
+// A pair of types that is being copied inline already goes to a helper function to stop the recursion.
+func (b *assignmentBuilder) copiesInline(lhs, rhs bmodel.Node) bool {
+	for _, c := range b.inlined {
+		if c.MarkHandle(lhs.ExprType(), rhs.ExprType()) {
+			return false
+		}
+	}
+	return b.opts.Style == gmodel.DstVarArg ||
+		b.opts.Proto && (isProtoMessage(lhs.ExprType()) || isProtoMessage(rhs.ExprType())) ||
+		b.opts.AddressesUnder(lhs.MatcherExpr(), rhs.MatcherExpr()) ||
+		!b.isNameable(util.DerefPtr(lhs.ExprType())) ||
+		!b.isNameable(util.DerefPtr(rhs.ExprType()))
+}
+
+// isNameable returns true if the generated code can refer to the type by name, that is,
+// the type is declared in the package being generated or exported from an imported package.
+func (b *assignmentBuilder) isNameable(t types.Type) bool {
+	switch typ := t.(type) {
+	case *types.Basic:
+		return true
+	case *types.Pointer:
+		return b.isNameable(typ.Elem())
+	case *types.Slice:
+		return b.isNameable(typ.Elem())
+	case *types.Array:
+		return b.isNameable(typ.Elem())
+	case *types.Map:
+		return b.isNameable(typ.Key()) && b.isNameable(typ.Elem())
+	case *types.Named:
+		if pkg := typ.Obj().Pkg(); pkg != nil && pkg != b.pkg.Types {
+			if _, ok := b.imports.LookupName(pkg.Path()); !ok || !typ.Obj().Exported() {
+				return false
+			}
+		}
+		args := typ.TypeArgs()
+		for i := 0; i < args.Len(); i++ {
+			if !b.isNameable(args.At(i)) {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+// importName returns the name by which the generated code refers to the package of the path.
+func (b *assignmentBuilder) importName(path string) string {
+	if name, ok := b.imports.LookupName(path); ok {
+		return name
+	}
+	return path[strings.LastIndex(path, "/")+1:]
+}
+
+// helperFor returns the helper function that copies rhsType to lhsType under the options of b.
+// It builds a new one if there is none yet.
+func (p *FunctionBuilder) helperFor(b *assignmentBuilder, lhsType, rhsType types.Type) (*helper, error) {
+	opts := b.opts.ForHelper()
+	for _, h := range p.helpers {
+		// A helper that may return an error but doesn't serves the methods that don't return one, too.
+		sameErr := h.allowErr == b.retError || (h.allowErr && !h.building && !h.copier.RetError)
+		if sameErr && reflect.DeepEqual(h.copier.Opts, opts) && h.copier.MarkHandle(lhsType, rhsType) {
+			if h.building {
+				h.copier.Recursive = true
+			}
+			if h.alias != nil {
+				return h.alias, nil
+			}
+			return h, nil
+		}
+	}
+
+	name := p.helperName(rhsType, lhsType)
+	logger.Printf("%v: helper function %v for %v to %v",
+		p.fset.Position(b.methodPos), name, p.imports.TypeName(rhsType), p.imports.TypeName(lhsType))
+
+	c := bmodel.NewCopier(name, lhsType, rhsType)
+	c.Opts, c.RetError = opts, b.retError
+	srcVar := p.createVar(types.NewVar(token.NoPos, nil, "", rhsType), "src")
+	dstVar := p.createVar(types.NewVar(token.NoPos, nil, "", lhsType), "dst")
+	h := &helper{
+		copier:    c,
+		function:  &gmodel.Function{Name: name, Src: srcVar, Dst: dstVar, DstVarStyle: gmodel.DstVarReturn},
+		converter: option.NewFieldConverter(name, "", "", b.methodPos),
+		allowErr:  b.retError,
+		building:  true,
+	}
+	// Until the body is built, the recursive calls in it assume that the function doesn't return an error.
+	h.converter.Set(rhsType, lhsType, false)
+	p.helpers = append(p.helpers, h)
+	index := len(p.helpers)
+
+	build := func() ([]gmodel.Assignment, error) {
+		hb := &assignmentBuilder{
+			file:      p.file,
+			fset:      p.fset,
+			pkg:       p.pkg,
+			imports:   p.imports,
+			methodPos: b.methodPos,
+			opts:      opts,
+			lhsVar:    dstVar,
+			rhsVars:   []gmodel.Var{srcVar},
+			retError:  b.retError,
+			siblings:  b.siblings,
+			owner:     p,
+			funcName:  name,
+			consumed:  map[string]struct{}{},
+		}
+		lhs := bmodel.NewRootNode(dstVar.Name, lhsType)
+		rhs := bmodel.NewRootNode(srcVar.Name, rhsType)
+		hb.rhsRoots = []bmodel.Node{rhs}
+		assignments, _, err := hb.structToStruct(lhs, rhs, false)
+		if err != nil {
+			return nil, err
+		}
+		if opts.Strict {
+			if err = p.checkUnmatched(b.methodPos, name, lhsType, assignments); err != nil {
+				return nil, err
+			}
+		}
+		return assignments, nil
+	}
+
+	assignments, err := build()
+	c.RetError = b.retError && returnsError(assignments)
+	if c.RetError && c.Recursive {
+		// The recursive calls turn out to return an error, too. Build the body again along with the helpers
+		// built inside it, which may call this function back.
+		h.converter.Set(rhsType, lhsType, true)
+		p.helpers = p.helpers[:index]
+		assignments, err = build()
+	}
+	h.building = false
+	if err != nil {
+		return nil, err
+	}
+	h.converter.Set(rhsType, lhsType, c.RetError)
+	h.function.Assignments = assignments
+	h.function.RetError = c.RetError
+
+	// Different options may still end up in the same function, which serves in place of the new one.
+	if !c.Recursive {
+		for _, other := range p.helpers {
+			if other != h && other.alias == nil && other.isEquivalent(h) {
+				h.alias = other
+				return other, nil
+			}
+		}
+	}
+	return h, nil
+}
+
+// isEquivalent returns true if h and other are the same function but their names.
+func (h *helper) isEquivalent(other *helper) bool {
+	if h.building || h.copier.Recursive || h.copier.RetError != other.copier.RetError ||
+		!types.Identical(h.copier.LHS, other.copier.LHS) || !types.Identical(h.copier.RHS, other.copier.RHS) ||
+		len(h.function.Assignments) != len(other.function.Assignments) {
+		return false
+	}
+	for i, a := range h.function.Assignments {
+		if a.String() != other.function.Assignments[i].String() {
+			return false
+		}
+	}
+	return true
+}
+
+// returnsError returns true if any of the assignments, including the nested ones, returns an error.
+func returnsError(assignments []gmodel.Assignment) bool {
+	for _, a := range assignments {
+		switch a := a.(type) {
+		case gmodel.NestStruct:
+			if returnsError(a.Contents) {
+				return true
+			}
+		case gmodel.IfAssignment:
+			if returnsError([]gmodel.Assignment{a.Inner, a.Else}) {
+				return true
+			}
+		case nil:
+		default:
+			if a.RetError() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// helperName returns a name for the helper function that copies src to dst, such as "categoryToModelCategory".
+// The name doesn't collide with the declarations in the setup file, the methods and the other helpers.
+func (p *FunctionBuilder) helperName(src, dst types.Type) string {
+	base := lowerFirst(typeIdent(p.imports.TypeName(src))) + "To" + typeIdent(p.imports.TypeName(dst))
+	name := base
+	for i := 2; p.isNameTaken(name); i++ {
+		name = fmt.Sprintf("%v%d", base, i)
+	}
+	return name
+}
+
+// isNameTaken returns true if name is declared in the setup file or used by a generated function.
+func (p *FunctionBuilder) isNameTaken(name string) bool {
+	if obj := p.pkg.Types.Scope().Lookup(name); obj != nil && p.fset.File(obj.Pos()) == p.fset.File(p.file.Pos()) {
+		return true
+	}
+	for _, m := range p.methods {
+		if m.Name() == name {
+			return true
+		}
+	}
+	for _, h := range p.helpers {
+		if h.function.Name == name {
+			return true
+		}
+	}
+	for _, h := range p.enumHelpers {
+		if h.function != nil && h.function.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// typeIdent turns a type name into an identifier in camel case, such as "ModelCategory" for "model.Category".
+func typeIdent(typeName string) string {
+	words := strings.FieldsFunc(typeName, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+	})
+	var sb strings.Builder
+	for _, w := range words {
+		sb.WriteString(strings.ToUpper(w[:1]))
+		sb.WriteString(w[1:])
+	}
+	return sb.String()
+}
+
+// lowerFirst lowers the leading upper case letters of s, leaving the last one of them if it begins
+// the next word, such as "urlInfo" for "URLInfo" and "category" for "Category".
+func lowerFirst(s string) string {
+	runes := []rune(s)
+	n := 0
+	for n < len(runes) && unicode.IsUpper(runes[n]) {
+		n++
+	}
+	if 1 < n && n < len(runes) && unicode.IsLower(runes[n]) {
+		n--
+	}
+	for i := 0; i < n; i++ {
+		runes[i] = unicode.ToLower(runes[i])
+	}
+	return string(runes)
+}
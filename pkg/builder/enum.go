@@ -0,0 +1,346 @@
+package builder
+
+import (
+	"fmt"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"sort"
+	"strconv"
+
+	bmodel "github.com/reedom/convergen/pkg/builder/model"
+	gmodel "github.com/reedom/convergen/pkg/generator/model"
+	"github.com/reedom/convergen/pkg/logger"
+	"github.com/reedom/convergen/pkg/option"
+	"github.com/reedom/convergen/pkg/util"
+	"golang.org/x/tools/go/packages"
+)
+
+// enumHelper represents an inner function that converts an enum type from or to string, or to another enum
+// type, with a switch on its constants. It is shared by every conversion under the same notation.
+type enumHelper struct {
+	rule      *option.EnumString     // rule is the :enum:string notation that the function follows, or nil for :enum.
+	toString  bool                   // toString is true if the function converts the enum to string.
+	prefixes  []string               // prefixes is the constant name prefixes of the :enum notation.
+	function  *gmodel.Function       // function is the generated function, or nil if the types cannot be paired.
+	err       error                  // err is the reason why the types cannot be paired, if any.
+	converter *option.FieldConverter // converter calls the function.
+}
+
+// enumStringNode converts rhs to lhsType by the :enum:string notation, where either of them is the enum type
+// and the other is a string.
+// A value is named after its constant, or by the function of the notation. Parsing a string returns an error
+// for the names of no constants, so that it is available only if the function returns an error.
+func (b *assignmentBuilder) enumStringNode(lhsType types.Type, rhs bmodel.Node) (bmodel.Node, bool, error) {
+	if rule := b.lookupEnumString(rhs.ExprType()); rule != nil && isString(lhsType) {
+		var c bmodel.Node
+		switch {
+		case rule.IsMethod():
+			c = bmodel.NewExprNode(rhs, "", "."+rule.NameFunc()+"()", util.StringType(), false)
+		case rule.NameFunc() != "":
+			c = bmodel.NewExprNode(rhs, rule.NameFunc()+"(", ")", util.StringType(), false)
+		default:
+			c = bmodel.NewConverterNode(rhs, b.owner.enumHelperFor(b, rule, true).converter)
+		}
+		return b.castNode(lhsType, c)
+	}
+
+	rule := b.lookupEnumString(lhsType)
+	if rule == nil {
+		return nil, false, nil
+	}
+	arg, ok, err := b.castNode(util.StringType(), rhs)
+	if !ok || err != nil || arg.ReturnsError() {
+		return nil, false, err
+	}
+	if !b.retError {
+		logger.Warnf("%v: cannot parse %v into %v since the function does not return an error",
+			b.fset.Position(b.methodPos), rhs.AssignExpr(), b.imports.TypeName(lhsType))
+		return nil, false, nil
+	}
+	return bmodel.NewConverterNode(arg, b.owner.enumHelperFor(b, rule, false).converter), true, nil
+}
+
+// enumFunc returns the function that converts a value of rhsType to lhsType by the :enum:string or :enum
+// notation, such as an element of a slice, and whether it returns an error. A method that names the values
+// is returned as a method expression, such as "Role.Label".
+func (b *assignmentBuilder) enumFunc(lhsType, rhsType types.Type) (fn string, retErr, ok bool, err error) {
+	if rule := b.lookupEnumString(rhsType); rule != nil && types.Identical(lhsType, util.StringType()) {
+		switch {
+		case rule.IsMethod():
+			return b.imports.TypeName(rhsType) + "." + rule.NameFunc(), false, true, nil
+		case rule.NameFunc() != "":
+			return rule.NameFunc(), false, true, nil
+		}
+		return b.owner.enumHelperFor(b, rule, true).converter.Converter(), false, true, nil
+	}
+
+	if rule := b.lookupEnumString(lhsType); rule != nil && types.Identical(rhsType, util.StringType()) {
+		if !b.retError {
+			logger.Warnf("%v: cannot parse %v into %v since the function does not return an error",
+				b.fset.Position(b.methodPos), b.imports.TypeName(rhsType), b.imports.TypeName(lhsType))
+			return "", false, false, nil
+		}
+		return b.owner.enumHelperFor(b, rule, false).converter.Converter(), true, true, nil
+	}
+
+	if b.opts.Enum {
+		h, ok, err := b.owner.enumMapHelperFor(b, lhsType, rhsType)
+		if !ok || err != nil {
+			return "", false, false, err
+		}
+		return h.converter.Converter(), false, true, nil
+	}
+	return "", false, false, nil
+}
+
+// enumNode converts rhs to lhsType by the :enum notation, where both of them are enum types,
+// such as domain.Status to pb.Status, by pairing their constants by name.
+func (b *assignmentBuilder) enumNode(lhsType types.Type, rhs bmodel.Node) (bmodel.Node, bool, error) {
+	h, ok, err := b.owner.enumMapHelperFor(b, lhsType, rhs.ExprType())
+	if !ok || err != nil {
+		return nil, false, err
+	}
+	return bmodel.NewConverterNode(rhs, h.converter), true, nil
+}
+
+// lookupEnumString returns the :enum:string notation for the enum type t, or nil if there is none.
+// The last declared one wins so that method-level notations take precedence over interface-level ones.
+func (b *assignmentBuilder) lookupEnumString(t types.Type) *option.EnumString {
+	for i := len(b.opts.EnumStrings) - 1; 0 <= i; i-- {
+		if rule := b.opts.EnumStrings[i]; rule.MatchType(t) {
+			return rule
+		}
+	}
+	return nil
+}
+
+// enumHelperFor returns the helper function that converts the enum type of rule to string if toString is true,
+// or from string otherwise. It builds a new one if there is none yet.
+func (p *FunctionBuilder) enumHelperFor(b *assignmentBuilder, rule *option.EnumString, toString bool) *enumHelper {
+	for _, h := range p.enumHelpers {
+		if h.rule == rule && h.toString == toString {
+			return h
+		}
+	}
+
+	enumType, strType := rule.EnumType(), util.StringType()
+	srcType, dstType := types.Type(strType), enumType
+	if toString {
+		srcType, dstType = enumType, strType
+	}
+	name := p.helperName(srcType, dstType)
+	logger.Printf("%v: helper function %v for %v to %v",
+		p.fset.Position(b.methodPos), name, p.imports.TypeName(srcType), p.imports.TypeName(dstType))
+
+	srcVar := p.createVar(types.NewVar(token.NoPos, nil, "", srcType), "src")
+	dstVar := p.createVar(types.NewVar(token.NoPos, nil, "", dstType), "dst")
+	sw := &gmodel.SwitchAssignment{Expr: srcVar.Name}
+	typeName := p.imports.TypeName(enumType)
+	if toString {
+		// Constants of the same value cannot share a switch, so that the first one names the value.
+		seen := map[string]struct{}{}
+		for _, c := range rule.Consts() {
+			if _, ok := seen[c.Val().ExactString()]; ok {
+				continue
+			}
+			seen[c.Val().ExactString()] = struct{}{}
+			sw.Cases = append(sw.Cases, &gmodel.SwitchCase{
+				Cond:        b.constName(c),
+				Assignments: []gmodel.Assignment{gmodel.SimpleField{LHS: dstVar.Name, RHS: strconv.Quote(c.Name())}},
+			})
+		}
+		sw.Default = []gmodel.Assignment{gmodel.SimpleField{
+			LHS: dstVar.Name,
+			RHS: fmt.Sprintf("fmt.Sprintf(%q, %v)", typeName+"(%d)", srcVar.Name),
+		}}
+	} else {
+		for _, c := range rule.Consts() {
+			cond := strconv.Quote(c.Name())
+			if rule.IsMethod() {
+				cond = b.constName(c) + "." + rule.NameFunc() + "()"
+			} else if rule.NameFunc() != "" {
+				cond = rule.NameFunc() + "(" + b.constName(c) + ")"
+			}
+			sw.Cases = append(sw.Cases, &gmodel.SwitchCase{
+				Cond:        cond,
+				Assignments: []gmodel.Assignment{gmodel.SimpleField{LHS: dstVar.Name, RHS: b.constName(c)}},
+			})
+		}
+		sw.Default = []gmodel.Assignment{gmodel.RawAssignment{
+			Raw: fmt.Sprintf("err = fmt.Errorf(%q, %v)\n", "unknown "+typeName+" %q", srcVar.Name),
+		}}
+	}
+
+	h := &enumHelper{
+		rule:     rule,
+		toString: toString,
+		function: &gmodel.Function{
+			Name:        name,
+			Src:         srcVar,
+			Dst:         dstVar,
+			RetError:    !toString,
+			DstVarStyle: gmodel.DstVarReturn,
+			Assignments: []gmodel.Assignment{sw},
+		},
+		converter: option.NewFieldConverter(name, "", "", rule.Pos()),
+	}
+	h.converter.Set(srcType, dstType, !toString)
+	p.enumHelpers = append(p.enumHelpers, h)
+	return h
+}
+
+// constName returns the name by which the generated code refers to the constant c.
+func (b *assignmentBuilder) constName(c *types.Const) string {
+	if c.Pkg() == nil || c.Pkg() == b.pkg.Types {
+		return c.Name()
+	}
+	return b.importName(c.Pkg().Path()) + "." + c.Name()
+}
+
+// enumMapHelperFor returns the helper function that converts the enum type rhsType to another one, lhsType,
+// by the :enum notation. It builds a new one if there is none yet.
+// The constants are paired by their names normalized in the way of the normalized matching rule, after the
+// prefixes of the notation are trimmed. Every source constant must have its counterpart of its own; otherwise,
+// the generation fails. A value of no source constant converts to the zero value.
+func (p *FunctionBuilder) enumMapHelperFor(b *assignmentBuilder, lhsType, rhsType types.Type) (*enumHelper, bool, error) {
+	if !isEnumType(lhsType) || !isEnumType(rhsType) || types.Identical(lhsType, rhsType) ||
+		!b.isNameable(lhsType) || !b.isNameable(rhsType) {
+		return nil, false, nil
+	}
+	for _, h := range p.enumHelpers {
+		if h.rule == nil && types.Identical(h.converter.ArgType(), rhsType) &&
+			types.Identical(h.converter.RetType(), lhsType) && equalStrings(h.prefixes, b.opts.EnumPrefixes) {
+			return h, h.function != nil, h.err
+		}
+	}
+
+	srcConsts, dstConsts := b.enumConsts(rhsType), b.enumConsts(lhsType)
+	if len(srcConsts) == 0 || len(dstConsts) == 0 {
+		return nil, false, nil
+	}
+
+	h := &enumHelper{
+		prefixes:  b.opts.EnumPrefixes,
+		converter: option.NewFieldConverter("", "", "", b.methodPos),
+	}
+	h.converter.Set(rhsType, lhsType, false)
+	p.enumHelpers = append(p.enumHelpers, h)
+
+	fail := func(format string, args ...any) (*enumHelper, bool, error) {
+		h.err = logger.Errorf("%v: "+format, append([]any{b.fset.Position(b.methodPos)}, args...)...)
+		return nil, false, h.err
+	}
+
+	// Longer prefixes come first so that "Status_STATUS" is trimmed rather than "Status".
+	prefixes := append([]string{}, b.opts.EnumPrefixes...)
+	sort.SliceStable(prefixes, func(i, j int) bool {
+		return len(prefixes[j]) < len(prefixes[i])
+	})
+	key := func(c *types.Const) string {
+		return option.NormalizeFieldName(c.Name(), prefixes, nil)
+	}
+
+	if _, c1, c2 := indexConsts(srcConsts, key); c1 != nil {
+		return fail("%v and %v are both paired with %q", b.constName(c1), b.constName(c2), key(c1))
+	}
+	dsts, c1, c2 := indexConsts(dstConsts, key)
+	if c1 != nil {
+		return fail("%v and %v are both paired with %q", b.constName(c1), b.constName(c2), key(c1))
+	}
+
+	name := p.helperName(rhsType, lhsType)
+	logger.Printf("%v: helper function %v for %v to %v",
+		p.fset.Position(b.methodPos), name, p.imports.TypeName(rhsType), p.imports.TypeName(lhsType))
+
+	srcVar := p.createVar(types.NewVar(token.NoPos, nil, "", rhsType), "src")
+	dstVar := p.createVar(types.NewVar(token.NoPos, nil, "", lhsType), "dst")
+	sw := &gmodel.SwitchAssignment{Expr: srcVar.Name}
+	// Constants of the same value cannot share a switch, so that the first one stands for the value.
+	seen := map[string]struct{}{}
+	for _, c := range srcConsts {
+		dst, ok := dsts[key(c)]
+		if !ok {
+			return fail("%v has no counterpart in %v", b.constName(c), p.imports.TypeName(lhsType))
+		}
+		if _, ok := seen[c.Val().ExactString()]; ok {
+			continue
+		}
+		seen[c.Val().ExactString()] = struct{}{}
+		sw.Cases = append(sw.Cases, &gmodel.SwitchCase{
+			Cond:        b.constName(c),
+			Assignments: []gmodel.Assignment{gmodel.SimpleField{LHS: dstVar.Name, RHS: b.constName(dst)}},
+		})
+	}
+
+	h.function = &gmodel.Function{
+		Name:        name,
+		Src:         srcVar,
+		Dst:         dstVar,
+		DstVarStyle: gmodel.DstVarReturn,
+		Assignments: []gmodel.Assignment{sw},
+	}
+	h.converter = option.NewFieldConverter(name, "", "", b.methodPos)
+	h.converter.Set(rhsType, lhsType, false)
+	return h, true, nil
+}
+
+// indexConsts maps the constants by their keys. Constants of the same value may share a key, but if two of
+// different values do, it returns them as well, since either of them could be paired.
+func indexConsts(consts []*types.Const, key func(c *types.Const) string) (m map[string]*types.Const, c1, c2 *types.Const) {
+	m = map[string]*types.Const{}
+	for _, c := range consts {
+		k := key(c)
+		other, ok := m[k]
+		if !ok {
+			m[k] = c
+		} else if !constant.Compare(other.Val(), token.EQL, c.Val()) {
+			return m, other, c
+		}
+	}
+	return m, nil, nil
+}
+
+// enumConsts returns the constants of the enum type t, enumerated the same way the parser does for
+// the mask bits, or nil if there is none.
+func (b *assignmentBuilder) enumConsts(t types.Type) []*types.Const {
+	named, ok := t.(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return nil
+	}
+
+	var pkg *packages.Package
+	packages.Visit([]*packages.Package{b.pkg}, func(p *packages.Package) bool {
+		if p.Types == named.Obj().Pkg() {
+			pkg = p
+		}
+		return pkg == nil
+	}, nil)
+	if pkg == nil || pkg.TypesInfo == nil {
+		return nil
+	}
+
+	consts, err := util.EnumConsts(pkg, t)
+	if err != nil {
+		return nil
+	}
+	return consts
+}
+
+// isEnumType returns true if t is a named integer type, which may have constants.
+func isEnumType(t types.Type) bool {
+	return util.IsNamedType(t) && isInteger(t)
+}
+
+// equalStrings returns true if a and b hold the same strings in the same order.
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
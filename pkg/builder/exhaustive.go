@@ -0,0 +1,78 @@
+package builder
+
+import (
+	"fmt"
+	"strings"
+
+	bmodel "github.com/reedom/convergen/pkg/builder/model"
+	gmodel "github.com/reedom/convergen/pkg/generator/model"
+	"github.com/reedom/convergen/pkg/logger"
+)
+
+// consumeIfAssigned records the top-level source field that rhs derives from as consumed if *a assigns anything.
+// It is deferred until the assignment is settled, so that a source field that matches by name but fails
+// to be assigned is still reported as unused.
+func (b *assignmentBuilder) consumeIfAssigned(a *gmodel.Assignment, rhs bmodel.Node) {
+	switch (*a).(type) {
+	case nil, gmodel.NoMatchField, gmodel.SkipField:
+		return
+	}
+	b.consume(rhs)
+}
+
+// consume records the top-level source field that node derives from as consumed.
+// A source root itself consumes all of its fields.
+func (b *assignmentBuilder) consume(node bmodel.Node) {
+	if root, ok := node.(bmodel.RootNode); ok {
+		b.consumeAll(root)
+		return
+	}
+	for ; node != nil && node.Parent() != nil; node = node.Parent() {
+		if root, ok := node.Parent().(bmodel.RootNode); ok {
+			b.consumed[sourceKey(root, node.ObjName())] = struct{}{}
+			return
+		}
+	}
+}
+
+// consumeAll records every field of the source root as consumed.
+func (b *assignmentBuilder) consumeAll(root bmodel.Node) {
+	bmodel.IterateStructFields(root, func(node bmodel.Node) (done bool) {
+		b.consumed[sourceKey(root, node.ObjName())] = struct{}{}
+		return
+	})
+}
+
+// reportUnusedSources reports the fields of the sources that no assignment consumed.
+// They are warnings, or errors in the strict mode. Fields that match :ignore:src are left out.
+func (b *assignmentBuilder) reportUnusedSources() error {
+	posStr := b.fset.Position(b.methodPos)
+	var msgs []string
+	for _, root := range b.rhsRoots {
+		root := root
+		bmodel.IterateStructFields(root, func(node bmodel.Node) (done bool) {
+			name := node.ObjName()
+			if !b.isStructFieldAccessible(root, name) || fieldDirectives(node).Skip || b.opts.ShouldIgnoreSrc(name) {
+				return
+			}
+			if _, ok := b.consumed[sourceKey(root, name)]; ok {
+				return
+			}
+			if b.opts.Strict {
+				msgs = append(msgs, fmt.Sprintf("%v: %v is not used in strict mode, use :ignore:src to drop it", posStr, node.AssignExpr()))
+			} else {
+				logger.Warnf("%v: %v is not used", posStr, node.AssignExpr())
+			}
+			return
+		})
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+	return logger.Errorf("%v", strings.Join(msgs, "\n"))
+}
+
+// sourceKey returns the key of the field of the source root to record its consumption.
+func sourceKey(root bmodel.Node, name string) string {
+	return root.AssignExpr() + "." + name
+}
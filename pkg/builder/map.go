@@ -0,0 +1,111 @@
+package builder
+
+import (
+	"go/types"
+
+	bmodel "github.com/reedom/convergen/pkg/builder/model"
+	gmodel "github.com/reedom/convergen/pkg/generator/model"
+	"github.com/reedom/convergen/pkg/option"
+	"github.com/reedom/convergen/pkg/util"
+)
+
+// mapKeySuffix is the suffix of a destination pattern that specifies the keys of a map field,
+// as "[]" does for the elements of a slice field or the values of a map field.
+const mapKeySuffix = "[key]"
+
+// mapToMap attempts to create an assignment that copies the entries of the rhs map into a new map,
+// converting the keys and the values as needed. It returns nil if either of them cannot be converted.
+func (b *assignmentBuilder) mapToMap(lhs, rhs bmodel.Node) (gmodel.Assignment, error) {
+	lhsMap := util.MapType(lhs.ExprType())
+	rhsMap := util.MapType(rhs.ExprType())
+
+	key, ok, err := b.elemNode(lhsMap.Key(), bmodel.NewScalarNode(nil, "k", rhsMap.Key()), lhs.MatcherExpr()+mapKeySuffix)
+	if !ok || err != nil {
+		return nil, err
+	}
+	value, ok, err := b.elemNode(lhsMap.Elem(), bmodel.NewScalarNode(nil, "v", rhsMap.Elem()), lhs.MatcherExpr()+"[]")
+	if !ok || err != nil {
+		return nil, err
+	}
+
+	return gmodel.MapAssignment{
+		LHS:        lhs.AssignExpr(),
+		RHS:        rhs.AssignExpr(),
+		Typ:        b.imports.TypeName(lhs.ExprType()),
+		Key:        key.AssignExpr(),
+		KeyTyp:     b.imports.TypeName(lhsMap.Key()),
+		KeyError:   key.ReturnsError(),
+		Value:      value.AssignExpr(),
+		ValueTyp:   b.imports.TypeName(lhsMap.Elem()),
+		ValueError: value.ReturnsError(),
+	}, nil
+}
+
+// elemNode converts an element of a container into lhsType.
+// It tries the converters specified for dstPattern, then castNode, and then the other methods
+// being generated together.
+func (b *assignmentBuilder) elemNode(lhsType types.Type, rhs bmodel.Node, dstPattern string) (bmodel.Node, bool, error) {
+	for _, converter := range b.opts.Converters {
+		if converter.Dst().Match(dstPattern, true) {
+			c, err := b.convertNode(lhsType, rhs, converter)
+			if c != nil || err != nil {
+				return c, c != nil, err
+			}
+		}
+	}
+	if c, ok, err := b.castNode(lhsType, rhs); ok || err != nil {
+		return c, ok, err
+	}
+	c, ok := b.siblingNode(lhsType, rhs)
+	return c, ok, nil
+}
+
+// siblingNode looks for a method being generated together that converts rhs into lhsType,
+// and returns a node that calls its function.
+// The function may take the address of rhs and may return a pointer to lhsType, unless it returns an error
+// as well, since the call can't be dereferenced in place then.
+func (b *assignmentBuilder) siblingNode(lhsType types.Type, rhs bmodel.Node) (bmodel.Node, bool) {
+	for _, m := range b.siblings {
+		paramType, retType, retError, ok := siblingSignature(m)
+		if !ok || (retError && !b.retError) {
+			continue
+		}
+
+		arg := rhs
+		if !types.AssignableTo(rhs.ExprType(), paramType) {
+			if !types.AssignableTo(types.NewPointer(rhs.ExprType()), paramType) {
+				continue
+			}
+			arg = bmodel.NewScalarNode(nil, "&"+rhs.AssignExpr(), paramType)
+		}
+
+		converter := option.NewFieldConverter(m.Name(), "", "", m.Method.Pos())
+		converter.Set(paramType, retType, retError)
+		var node bmodel.Node = bmodel.NewConverterNode(arg, converter)
+		if !types.AssignableTo(retType, lhsType) {
+			if retError || !util.IsPtr(retType) || !types.AssignableTo(util.DerefPtr(retType), lhsType) {
+				continue
+			}
+			node = bmodel.NewDerefNode(node)
+		}
+		return node, true
+	}
+	return nil, false
+}
+
+// siblingSignature returns the types of the method if its function converts a single value
+// into the returning one, optionally with an error.
+func siblingSignature(m *bmodel.MethodEntry) (paramType, retType types.Type, retError, ok bool) {
+	if m.Opts.Receiver != "" || m.Opts.Style != gmodel.DstVarReturn {
+		return
+	}
+	params := m.ParamVars()
+	results := m.Results()
+	if len(params) != 1 || len(results) == 0 || 2 < len(results) {
+		return
+	}
+	if len(results) == 2 && !util.IsErrorType(results[1]) {
+		return
+	}
+	return params[0].Type(), results[0], len(results) == 2, true
+}
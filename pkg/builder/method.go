@@ -1,9 +1,11 @@
 package builder
 
 import (
+	"fmt"
 	"go/ast"
 	"go/token"
 	"go/types"
+	"strings"
 
 	bmodel "github.com/reedom/convergen/pkg/builder/model"
 	gmodel "github.com/reedom/convergen/pkg/generator/model"
@@ -15,10 +17,16 @@ import (
 // FunctionBuilder is a struct responsible for building functions from
 // method entries.
 type FunctionBuilder struct {
-	file    *ast.File         // The AST file containing the method.
-	fset    *token.FileSet    // The fileset used to read the method.
-	pkg     *packages.Package // The package where the method belongs.
-	imports util.ImportNames  // The import names to be used.
+	file    *ast.File             // The AST file containing the method.
+	fset    *token.FileSet        // The fileset used to read the method.
+	pkg     *packages.Package     // The package where the method belongs.
+	imports util.ImportNames      // The import names to be used.
+	methods []*bmodel.MethodEntry // The methods being generated together, which can convert elements for each other.
+	helpers []*helper             // The helper functions that copy nested structs, shared by all the methods.
+	emitted int                   // The number of the helpers already returned by CreateFunctions.
+
+	enumHelpers []*enumHelper // The helper functions that convert enums from and to string or other enums, shared by all the methods.
+	enumEmitted int           // The number of the enum helpers already returned by CreateFunctions.
 }
 
 // NewFunctionBuilder is a constructor that returns a new instance of
@@ -41,6 +49,7 @@ func NewFunctionBuilder(
 // method entries.
 func (p *FunctionBuilder) CreateFunctions(methods []*bmodel.MethodEntry) ([]*gmodel.Function, error) {
 	functions := make([]*gmodel.Function, len(methods))
+	p.methods = methods
 	var err error
 	for i, method := range methods {
 		functions[i], err = p.CreateFunction(method)
@@ -48,6 +57,19 @@ func (p *FunctionBuilder) CreateFunctions(methods []*bmodel.MethodEntry) ([]*gmo
 			return nil, err
 		}
 	}
+	// The helpers built for these methods follow them.
+	for _, h := range p.helpers[p.emitted:] {
+		if h.alias == nil {
+			functions = append(functions, h.function)
+		}
+	}
+	p.emitted = len(p.helpers)
+	for _, h := range p.enumHelpers[p.enumEmitted:] {
+		if h.function != nil {
+			functions = append(functions, h.function)
+		}
+	}
+	p.enumEmitted = len(p.enumHelpers)
 	return functions, nil
 }
 
@@ -57,6 +79,11 @@ func (p *FunctionBuilder) CreateFunction(m *bmodel.MethodEntry) (*gmodel.Functio
 	comments := util.ToTextList(m.DocComment)
 	src := m.SrcVar()
 	dst := m.DstVar()
+	for _, name := range m.Opts.Sources {
+		if !hasSource(m, name) {
+			return nil, logger.Errorf("%v: :src %v is not a struct argument of %v", p.fset.Position(m.Method.Pos()), name, m.Name())
+		}
+	}
 
 	if util.IsInvalidType(src.Type()) {
 		return nil, logger.Errorf("%v: src type is not defined. make sure to be imported", p.fset.Position(src.Pos()))
@@ -87,27 +114,68 @@ func (p *FunctionBuilder) CreateFunction(m *bmodel.MethodEntry) (*gmodel.Functio
 		srcVar.Name = m.Opts.Receiver
 	}
 
+	// The other arguments are either additional sources or extra arguments such as context.Context,
+	// which are passed to converters and manipulators that ask for them.
+	srcs := []*types.Var{src}
+	srcVars := []gmodel.Var{srcVar}
+	var params []gmodel.Var
+	var args []funcArg
+	for i, v := range m.ParamVars() {
+		var paramVar gmodel.Var
+		switch {
+		case v == src:
+			paramVar = srcVar
+		case util.IsInvalidType(v.Type()):
+			return nil, logger.Errorf("%v: arg type is not defined. make sure to be imported", p.fset.Position(v.Pos()))
+		case isSource(m, v):
+			paramVar = p.createVar(v, fmt.Sprintf("%v%d", srcDefName, len(srcs)+1))
+			srcs = append(srcs, v)
+			srcVars = append(srcVars, paramVar)
+		case util.IsContextType(v.Type()):
+			paramVar = p.createVar(v, "ctx")
+		default:
+			paramVar = p.createVar(v, fmt.Sprintf("arg%d", i+1))
+		}
+
+		args = append(args, funcArg{name: paramVar.Name, typ: v.Type()})
+		if v != src || m.Opts.Receiver == "" {
+			params = append(params, paramVar)
+		}
+	}
+	if 1 < len(srcs) && m.Opts.Reverse {
+		return nil, logger.Errorf(`%v: ":reverse" cannot be used with more than one source`, p.fset.Position(m.Method.Pos()))
+	}
+
 	var (
 		assignments    []gmodel.Assignment
 		postAssignment gmodel.Assignment
 		err            error
 	)
 	if m.Opts.Reverse {
-		builder := newAssignmentBuilder(p, m, srcVar, dstVar)
-		assignments, postAssignment, err = builder.build(src, dst, m.RetError())
+		builder := newAssignmentBuilder(p, m, args, srcVar, dstVar)
+		assignments, postAssignment, err = builder.build(src, []*types.Var{dst}, m.RetError())
 	} else {
-		builder := newAssignmentBuilder(p, m, dstVar, srcVar)
-		assignments, postAssignment, err = builder.build(dst, src, m.RetError())
+		builder := newAssignmentBuilder(p, m, args, dstVar, srcVars...)
+		assignments, postAssignment, err = builder.build(dst, srcs, m.RetError())
 	}
 	if err != nil {
 		return nil, err
 	}
+	if m.Opts.Strict {
+		lhs := dst
+		if m.Opts.Reverse {
+			lhs = src
+		}
+		if err = p.checkUnmatched(m.Method.Pos(), m.Name(), lhs.Type(), assignments); err != nil {
+			return nil, err
+		}
+	}
 
-	preProcess, err := p.buildManipulator(m.Opts.PreProcess, src, dst, m.RetError())
+	preProcess, err := p.buildManipulator(m.Opts.PreProcess, src, dst, args, m.RetError())
 	if err != nil {
 		return nil, err
 	}
-	postProcess, err := p.buildManipulator(m.Opts.PostProcess, src, dst, m.RetError())
+	postProcess, err := p.buildManipulator(m.Opts.PostProcess, src, dst, args, m.RetError())
 	if err != nil {
 		return nil, err
 	}
@@ -118,6 +186,8 @@ func (p *FunctionBuilder) CreateFunction(m *bmodel.MethodEntry) (*gmodel.Functio
 		Receiver:       m.Opts.Receiver,
 		FuncCutPrefix:  m.Opts.FuncCutPrefix,
 		Src:            srcVar,
+		Sources:        srcVars,
+		Params:         params,
 		Dst:            dstVar,
 		DstVarStyle:    m.Opts.Style,
 		RetError:       m.RetError(),
@@ -130,6 +200,88 @@ func (p *FunctionBuilder) CreateFunction(m *bmodel.MethodEntry) (*gmodel.Functio
 	return fn, nil
 }
 
+// hasSource returns true if the method has a struct argument of the name.
+func hasSource(m *bmodel.MethodEntry, name string) bool {
+	for _, v := range m.ParamVars() {
+		if v.Name() == name && bmodel.IsSourceVar(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// isSource returns true if v is one of the copy sources of the method.
+func isSource(m *bmodel.MethodEntry, v *types.Var) bool {
+	for _, src := range m.SrcVars() {
+		if src == v {
+			return true
+		}
+	}
+	return false
+}
+
+// checkUnmatched reports every destination field of dstType left unassigned in the assignments of the function
+// as an error, each at the position of the field, or of the method if it is not found.
+// It is for the strict mode; fields marked by :skip are not reported.
+func (p *FunctionBuilder) checkUnmatched(
+	methodPos token.Pos, funcName string, dstType types.Type, assignments []gmodel.Assignment,
+) error {
+	var msgs []string
+	var walk func(a gmodel.Assignment)
+	walk = func(a gmodel.Assignment) {
+		switch a := a.(type) {
+		case gmodel.NoMatchField:
+			pos := methodPos
+			if field := lookupFieldPath(dstType, a.LHS); field != nil {
+				pos = field.Pos()
+			}
+			msgs = append(msgs, fmt.Sprintf("%v: no assignment for %v in %v in strict mode, use :skip to leave it unassigned",
+				p.fset.Position(pos), a.LHS, funcName))
+		case gmodel.NestStruct:
+			for _, c := range a.Contents {
+				walk(c)
+			}
+		case gmodel.IfAssignment:
+			walk(a.Inner)
+			walk(a.Else)
+		case *gmodel.RepeatAssignment:
+			for _, c := range a.Assignments {
+				walk(c)
+			}
+		case *gmodel.SwitchAssignment:
+			for _, c := range a.Cases {
+				for _, inner := range c.Assignments {
+					walk(inner)
+				}
+			}
+		}
+	}
+	for _, a := range assignments {
+		walk(a)
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+	return logger.Errorf("%v", strings.Join(msgs, "\n"))
+}
+
+// lookupFieldPath returns the field of t that the assignment expression, such as "dst.Profile.Name", designates,
+// or nil if it is not a field path.
+func lookupFieldPath(t types.Type, expr string) *types.Var {
+	names := strings.Split(expr, ".")
+	var field *types.Var
+	for _, name := range names[1:] {
+		if strings.ContainsAny(name, "[(") {
+			return nil
+		}
+		if field = util.FindField(t, name, true); field == nil {
+			return nil
+		}
+		t = field.Type()
+	}
+	return field
+}
+
 // createVar creates a gmodel.Var from a types.Var.
 // If the types.Var doesn't have a name, defName is used instead.
 func (p *FunctionBuilder) createVar(v *types.Var, defName string) gmodel.Var {
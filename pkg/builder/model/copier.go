@@ -3,6 +3,7 @@ package model
 import (
 	"go/types"
 
+	"github.com/reedom/convergen/pkg/option"
 	"github.com/reedom/convergen/pkg/util"
 )
 
@@ -13,6 +14,9 @@ type Copier struct {
 	LHS         types.Type
 	RHS         types.Type
 	HandleCount int
+	Opts        option.Options // Opts are the options the inner function is built with.
+	RetError    bool           // RetError indicates whether the inner function returns an error.
+	Recursive   bool           // Recursive indicates that the inner function calls itself.
 }
 
 // NewCopier creates a new Copier.
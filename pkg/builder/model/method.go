@@ -3,6 +3,7 @@ package model
 import (
 	"go/ast"
 	"go/types"
+	"strings"
 
 	"github.com/reedom/convergen/pkg/generator/model"
 	"github.com/reedom/convergen/pkg/option"
@@ -90,15 +91,114 @@ func (m *MethodEntry) RetError() bool {
 	return 0 < len(ret) && util.IsErrorType(ret[len(ret)-1])
 }
 
-// SrcVar returns a variable that is a copy source.
-// It assumes that there is only one source variable.
+// SrcVar returns a variable that is the primary copy source.
+// It is the receiver in the receiver form, otherwise the first argument that :src names, or the first argument
+// of a struct type. If there is no such argument, it returns the first argument.
 func (m *MethodEntry) SrcVar() *types.Var {
+	params := m.ParamVars()
+	if len(params) == 0 {
+		return nil
+	}
+	if m.Opts.Receiver == "" {
+		for _, v := range params {
+			if m.isNamedSource(v) || (len(m.Opts.Sources) == 0 && IsSourceVar(v)) {
+				return v
+			}
+		}
+	}
+	return params[0]
+}
+
+// SrcVars returns variables that are copy sources in the order of the arguments.
+// The first one is the same as SrcVar returns.
+// Unless :src names them, the sources are the primary one and the struct arguments that immediately follow it,
+// except for those of the standard library such as time.Time. The other arguments are not sources but passed
+// to converters and manipulators that ask for them.
+func (m *MethodEntry) SrcVars() []*types.Var {
+	src := m.SrcVar()
+	if src == nil {
+		return nil
+	}
+
+	list := []*types.Var{src}
+	following := false
+	for _, v := range m.ParamVars() {
+		switch {
+		case v == src:
+			following = true
+		case len(m.Opts.Sources) != 0 && m.Opts.Receiver == "":
+			if m.isNamedSource(v) {
+				list = append(list, v)
+			}
+		case following && IsSourceVar(v) && !m.isStdLibVar(v):
+			list = append(list, v)
+		default:
+			following = false
+		}
+	}
+	return list
+}
+
+// isNamedSource returns true if :src names v.
+func (m *MethodEntry) isNamedSource(v *types.Var) bool {
+	for _, name := range m.Opts.Sources {
+		if v.Name() == name {
+			return true
+		}
+	}
+	return false
+}
+
+// isStdLibVar returns true if v is of a type of the standard library, such as time.Time.
+// As goimports does, a package whose path has no dot in its first element is taken to be of the standard library,
+// unless the path starts with the same element as the method's package.
+func (m *MethodEntry) isStdLibVar(v *types.Var) bool {
+	pkg := util.PkgOf(v.Type())
+	if pkg == nil {
+		return false
+	}
+	first, _, _ := strings.Cut(pkg.Path(), "/")
+	if strings.Contains(first, ".") {
+		return false
+	}
+	if own := m.Method.Pkg(); own != nil {
+		ownFirst, _, _ := strings.Cut(own.Path(), "/")
+		return first != ownFirst
+	}
+	return true
+}
+
+// ParamVars returns all the argument variables of the method.
+func (m *MethodEntry) ParamVars() []*types.Var {
 	sig := m.Method.Type().(*types.Signature)
 	params := sig.Params()
-	if params.Len() == 0 {
-		return nil
+
+	list := make([]*types.Var, 0, params.Len())
+	for i := 0; i < params.Len(); i++ {
+		list = append(list, params.At(i))
+	}
+	return list
+}
+
+// ParamVarsAround returns the arguments that precede and follow v.
+func (m *MethodEntry) ParamVarsAround(v *types.Var) (leading, trailing []*types.Var) {
+	found := false
+	for _, param := range m.ParamVars() {
+		switch {
+		case param == v:
+			found = true
+		case found:
+			trailing = append(trailing, param)
+		default:
+			leading = append(leading, param)
+		}
 	}
-	return params.At(0)
+	return
+}
+
+// IsSourceVar returns true if v can be a copy source, that is, of a struct or a pointer to a struct type.
+func IsSourceVar(v *types.Var) bool {
+	return util.IsStructType(util.DerefPtr(v.Type()))
 }
 
 // DstVar returns a variable that is a copy destination.
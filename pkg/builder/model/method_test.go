@@ -91,3 +91,61 @@ func main() {}`
 		//}
 	}
 }
+
+func TestMethodEntry_SrcVars(t *testing.T) {
+	src := `package main
+
+import (
+	"context"
+	"time"
+)
+
+type User struct{}
+type Profile struct{}
+type Options struct{}
+type Response struct{}
+
+type Convergen interface {
+	Merge(ctx context.Context, user *User, profile *Profile, at time.Time) *Response
+	WithOptions(user *User, opts *Options) *Response
+	Interleaved(user *User, locale string, profile *Profile) *Response
+}
+
+func main() {}`
+
+	_, _, pkg := loadSrc(t, src)
+	intf := pkg.Scope().Lookup("Convergen").Type().Underlying().(*types.Interface)
+	lookup := func(name string) types.Object {
+		for i := 0; i < intf.NumMethods(); i++ {
+			if intf.Method(i).Name() == name {
+				return intf.Method(i)
+			}
+		}
+		t.Fatalf("method %v not found", name)
+		return nil
+	}
+	names := func(vars []*types.Var) (list []string) {
+		for _, v := range vars {
+			list = append(list, v.Name())
+		}
+		return
+	}
+
+	cases := []struct {
+		method   string
+		sources  []string
+		expected []string
+	}{
+		{method: "Merge", expected: []string{"user", "profile"}},
+		{method: "WithOptions", expected: []string{"user", "opts"}},
+		{method: "WithOptions", sources: []string{"user"}, expected: []string{"user"}},
+		{method: "Interleaved", expected: []string{"user"}},
+		{method: "Interleaved", sources: []string{"profile", "user"}, expected: []string{"user", "profile"}},
+	}
+
+	for _, tt := range cases {
+		m := &model.MethodEntry{Method: lookup(tt.method), Opts: option.Options{Sources: tt.sources}}
+		require.Equal(t, tt.expected, names(m.SrcVars()), tt.method)
+		require.Equal(t, tt.expected[0], m.SrcVar().Name(), tt.method)
+	}
+}
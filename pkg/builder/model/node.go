@@ -3,6 +3,7 @@ package model
 import (
 	"fmt"
 	"go/types"
+	"strings"
 
 	"github.com/reedom/convergen/pkg/option"
 	"github.com/reedom/convergen/pkg/util"
@@ -175,6 +176,8 @@ func (n ScalarNode) NullCheckExpr() string {
 type ConverterNode struct {
 	arg       Node
 	converter *option.FieldConverter
+	leading   []string // Expressions passed to the parameters that precede arg.
+	trailing  []string // Expressions passed to the parameters that follow arg.
 }
 
 // NewConverterNode creates a new ConverterNode.
@@ -185,6 +188,16 @@ func NewConverterNode(arg Node, converter *option.FieldConverter) Node {
 	}
 }
 
+// NewConverterNodeWithArgs creates a new ConverterNode that passes extra arguments to the converter.
+func NewConverterNodeWithArgs(arg Node, converter *option.FieldConverter, leading, trailing []string) Node {
+	return ConverterNode{
+		arg:       arg,
+		converter: converter,
+		leading:   leading,
+		trailing:  trailing,
+	}
+}
+
 // Parent returns the container of the node or nil.
 func (n ConverterNode) Parent() Node {
 	return n.arg.Parent()
@@ -219,8 +232,14 @@ func (n ConverterNode) AssignExpr() string {
 	refStr := ""
 	if !util.IsPtr(n.arg.ExprType()) && util.IsPtr(n.converter.ArgType()) {
 		refStr = "&"
+	} else if util.IsPtr(n.arg.ExprType()) && !types.AssignableTo(n.arg.ExprType(), n.converter.ArgType()) {
+		refStr = "*"
 	}
-	return fmt.Sprintf("%v(%v%v)", n.converter.Converter(), refStr, n.arg.AssignExpr())
+	args := make([]string, 0, len(n.leading)+1+len(n.trailing))
+	args = append(args, n.leading...)
+	args = append(args, refStr+n.arg.AssignExpr())
+	args = append(args, n.trailing...)
+	return fmt.Sprintf("%v(%v)", n.converter.Converter(), strings.Join(args, ", "))
 }
 
 // MatcherExpr returns a value evaluate expression for assignment but omits the root variable name.
@@ -393,25 +412,21 @@ func NewTypecast(scope *types.Scope, imports util.ImportNames, t types.Type, inn
 
 	switch typ := util.DerefPtr(t).(type) {
 	case *types.Named:
+		var name string
 		// If the type is defined within the current package.
 		if scope.Lookup(typ.Obj().Name()) != nil {
-			if isPtr {
-				expr = fmt.Sprintf("(*%v)", typ.Obj().Name())
-			} else {
-				expr = typ.Obj().Name()
-			}
+			name = typ.Obj().Name()
 		} else if pkgName, ok := imports.LookupName(typ.Obj().Pkg().Path()); ok {
-			if isPtr {
-				expr = fmt.Sprintf("(*%v.%v)", pkgName, typ.Obj().Name())
-			} else {
-				expr = fmt.Sprintf("%v.%v", pkgName, typ.Obj().Name())
-			}
+			name = fmt.Sprintf("%v.%v", pkgName, typ.Obj().Name())
+		} else {
+			name = fmt.Sprintf("%v.%v", typ.Obj().Pkg().Name(), typ.Obj().Name())
+		}
+		name += imports.TypeArgs(typ)
+
+		if isPtr {
+			expr = fmt.Sprintf("(*%v)", name)
 		} else {
-			if isPtr {
-				expr = fmt.Sprintf("(*%v.%v)", typ.Obj().Pkg().Name(), typ.Obj().Name())
-			} else {
-				expr = fmt.Sprintf("%v.%v", typ.Obj().Pkg().Name(), typ.Obj().Name())
-			}
+			expr = name
 		}
 	case *types.Basic:
 		if isPtr {
@@ -504,6 +519,9 @@ func (e StringerEntry) ExprType() types.Type {
 // AssignExpr returns a value evaluate expression for assignment.
 // For example, it returns "dst.User.Name", "dst.User.Status()", "strconv.Itoa(dst.User.Score())", etc.
 func (e StringerEntry) AssignExpr() string {
+	if _, ok := e.inner.(DerefNode); ok {
+		return fmt.Sprintf("(%v).%v()", e.inner.AssignExpr(), e.funcName)
+	}
 	return fmt.Sprintf("%v.%v()", e.inner.AssignExpr(), e.funcName)
 }
 
@@ -543,3 +561,181 @@ func NewMethodCallNode(inner Node, funcName string) Node {
 func (e MethodCallNode) ExprType() types.Type {
 	return e.inner.ExprType()
 }
+
+// DerefNode is a node that represents a dereferenced pointer, such as "*src.Name".
+type DerefNode struct {
+	inner Node
+}
+
+// NewDerefNode creates a new DerefNode. inner must be of a pointer type.
+func NewDerefNode(inner Node) Node {
+	return DerefNode{inner: inner}
+}
+
+// ObjName returns the ident of the leaf element.
+// For example, it returns "Status" in both of dst.User.Status or dst.User.Status().
+func (n DerefNode) ObjName() string {
+	return n.inner.ObjName()
+}
+
+// Parent returns the container of the node or nil.
+func (n DerefNode) Parent() Node {
+	return n.inner.Parent()
+}
+
+// ExprType returns the evaluated result type of the node, which is the element type of the pointer.
+func (n DerefNode) ExprType() types.Type {
+	return util.DerefPtr(n.inner.ExprType())
+}
+
+// AssignExpr returns a value evaluate expression for assignment.
+// For example, it returns "*dst.User.Name".
+func (n DerefNode) AssignExpr() string {
+	return "*" + n.inner.AssignExpr()
+}
+
+// MatcherExpr returns a value evaluate expression for assignment but omits the root variable name.
+// For example, it returns "User.Status()" in "dst.User.Status()".
+func (n DerefNode) MatcherExpr() string {
+	return n.inner.MatcherExpr()
+}
+
+// NullCheckExpr returns a value evaluate expression for null check conditional.
+// For example, it returns "dst.User.Name" for "*dst.User.Name".
+func (n DerefNode) NullCheckExpr() string {
+	return n.inner.AssignExpr()
+}
+
+// ReturnsError indicates whether the expression returns an error object as the second returning value.
+func (n DerefNode) ReturnsError() bool {
+	return false
+}
+
+// ObjNullable indicates whether the node itself is a pointer type so that it can be nil at runtime.
+// The dereferenced value itself is not.
+func (n DerefNode) ObjNullable() bool {
+	return false
+}
+
+// SQLNullNode is a node that represents a valid value of a Null type of database/sql,
+// such as "sql.NullString{String: src.Name, Valid: true}".
+type SQLNullNode struct {
+	inner    Node
+	typ      types.Type
+	typeName string
+	field    string
+}
+
+// NewSQLNullNode creates a new SQLNullNode that holds inner in the field of the Null type typ.
+func NewSQLNullNode(inner Node, typ types.Type, typeName, field string) Node {
+	return SQLNullNode{inner: inner, typ: typ, typeName: typeName, field: field}
+}
+
+// ObjName returns the ident of the leaf element.
+// For example, it returns "Status" in both of dst.User.Status or dst.User.Status().
+func (n SQLNullNode) ObjName() string {
+	return n.inner.ObjName()
+}
+
+// Parent returns the container of the node or nil.
+func (n SQLNullNode) Parent() Node {
+	return n.inner.Parent()
+}
+
+// ExprType returns the evaluated result type of the node, which is the Null type.
+func (n SQLNullNode) ExprType() types.Type {
+	return n.typ
+}
+
+// AssignExpr returns a value evaluate expression for assignment.
+// For example, it returns "sql.NullString{String: src.Name, Valid: true}".
+func (n SQLNullNode) AssignExpr() string {
+	return fmt.Sprintf("%v{%v: %v, Valid: true}", n.typeName, n.field, n.inner.AssignExpr())
+}
+
+// MatcherExpr returns a value evaluate expression for assignment but omits the root variable name.
+// For example, it returns "User.Status()" in "dst.User.Status()".
+func (n SQLNullNode) MatcherExpr() string {
+	return n.inner.MatcherExpr()
+}
+
+// NullCheckExpr returns a value evaluate expression for null check conditional.
+// For example, it returns "dst.Node.Child".
+func (n SQLNullNode) NullCheckExpr() string {
+	return n.inner.NullCheckExpr()
+}
+
+// ReturnsError indicates whether the expression returns an error object as the second returning value.
+func (n SQLNullNode) ReturnsError() bool {
+	return false
+}
+
+// ObjNullable indicates whether the node itself is a pointer type so that it can be nil at runtime.
+// The Null value itself is not.
+func (n SQLNullNode) ObjNullable() bool {
+	return false
+}
+
+// ExprNode is a node that represents an expression around the inner node,
+// such as "src.Created.Unix()" and "time.Parse(time.RFC3339, src.Created)".
+type ExprNode struct {
+	inner  Node
+	prefix string
+	suffix string
+	typ    types.Type
+	retErr bool
+}
+
+// NewExprNode creates a new ExprNode that evaluates to a value of typ.
+// retErr indicates whether the expression returns an error as the second value.
+func NewExprNode(inner Node, prefix, suffix string, typ types.Type, retErr bool) Node {
+	return ExprNode{inner: inner, prefix: prefix, suffix: suffix, typ: typ, retErr: retErr}
+}
+
+// ObjName returns the ident of the leaf element.
+// For example, it returns "Status" in both of dst.User.Status or dst.User.Status().
+func (n ExprNode) ObjName() string {
+	return n.inner.ObjName()
+}
+
+// Parent returns the container of the node or nil.
+func (n ExprNode) Parent() Node {
+	return n.inner.Parent()
+}
+
+// ExprType returns the evaluated result type of the node.
+func (n ExprNode) ExprType() types.Type {
+	return n.typ
+}
+
+// AssignExpr returns a value evaluate expression for assignment.
+// For example, it returns "src.Created.Unix()" or "(*src.Created).Unix()".
+func (n ExprNode) AssignExpr() string {
+	expr := n.inner.AssignExpr()
+	if _, ok := n.inner.(DerefNode); ok && n.prefix == "" {
+		expr = "(" + expr + ")"
+	}
+	return n.prefix + expr + n.suffix
+}
+
+// MatcherExpr returns a value evaluate expression for assignment but omits the root variable name.
+// For example, it returns "User.Status()" in "dst.User.Status()".
+func (n ExprNode) MatcherExpr() string {
+	return n.inner.MatcherExpr()
+}
+
+// NullCheckExpr returns a value evaluate expression for null check conditional.
+// For example, it returns "dst.Node.Child".
+func (n ExprNode) NullCheckExpr() string {
+	return n.inner.NullCheckExpr()
+}
+
+// ReturnsError indicates whether the expression returns an error object as the second returning value.
+func (n ExprNode) ReturnsError() bool {
+	return n.retErr
+}
+
+// ObjNullable indicates whether the node itself is a pointer type so that it can be nil at runtime.
+func (n ExprNode) ObjNullable() bool {
+	return util.IsPtr(n.typ)
+}
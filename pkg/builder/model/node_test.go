@@ -59,6 +59,9 @@ func TestConverterNode(t *testing.T) {
 	assert.Equal(t, "myConverter(dst)", node.AssignExpr())
 	assert.Equal(t, "", node.MatcherExpr())
 	assert.Equal(t, "myConverter(dst)", node.NullCheckExpr())
+
+	node = model.NewConverterNodeWithArgs(arg, fc, []string{"ctx"}, []string{"locale"})
+	assert.Equal(t, "myConverter(ctx, dst, locale)", node.AssignExpr())
 }
 
 func TestTypecastEntry(t *testing.T) {
@@ -106,3 +109,55 @@ func TestStringerEntry(t *testing.T) {
 	assert.False(t, entry.ReturnsError())
 	assert.False(t, entry.ObjNullable())
 }
+
+func TestDerefNode(t *testing.T) {
+	parent := model.NewRootNode("src", types.NewPointer(types.NewStruct(nil, nil)))
+	inner := model.NewScalarNode(parent, "Name", types.NewPointer(types.Typ[types.String]))
+	node := model.NewDerefNode(inner)
+
+	assert.Equal(t, parent, node.Parent())
+	assert.Equal(t, "Name", node.ObjName())
+	assert.False(t, node.ObjNullable())
+	assert.Equal(t, types.Typ[types.String], node.ExprType())
+	assert.Equal(t, "*src", node.AssignExpr())
+	assert.Equal(t, "src", node.NullCheckExpr())
+	assert.False(t, node.ReturnsError())
+
+	assert.Equal(t, "(*src).String()", model.NewStringer(node).AssignExpr())
+}
+
+func TestSQLNullNode(t *testing.T) {
+	parent := model.NewRootNode("src", types.NewPointer(types.NewStruct(nil, nil)))
+	inner := model.NewScalarNode(parent, "Name", types.Typ[types.String])
+	typ := types.NewNamed(types.NewTypeName(token.NoPos, nil, "NullString", nil), types.NewStruct(nil, nil), nil)
+	node := model.NewSQLNullNode(inner, typ, "sql.NullString", "String")
+
+	assert.Equal(t, parent, node.Parent())
+	assert.Equal(t, "Name", node.ObjName())
+	assert.False(t, node.ObjNullable())
+	assert.Equal(t, typ, node.ExprType())
+	assert.Equal(t, "sql.NullString{String: src, Valid: true}", node.AssignExpr())
+	assert.Equal(t, "src", node.NullCheckExpr())
+	assert.False(t, node.ReturnsError())
+}
+
+func TestExprNode(t *testing.T) {
+	parent := model.NewRootNode("src", types.NewPointer(types.NewStruct(nil, nil)))
+	inner := model.NewScalarNode(parent, "Created", types.NewPointer(types.NewStruct(nil, nil)))
+	node := model.NewExprNode(inner, "", ".Unix()", types.Typ[types.Int64], false)
+
+	assert.Equal(t, parent, node.Parent())
+	assert.Equal(t, "Created", node.ObjName())
+	assert.False(t, node.ObjNullable())
+	assert.Equal(t, types.Typ[types.Int64], node.ExprType())
+	assert.Equal(t, "src.Unix()", node.AssignExpr())
+	assert.Equal(t, "src", node.NullCheckExpr())
+	assert.False(t, node.ReturnsError())
+
+	deref := model.NewExprNode(model.NewDerefNode(inner), "", ".Unix()", types.Typ[types.Int64], false)
+	assert.Equal(t, "(*src).Unix()", deref.AssignExpr())
+
+	parse := model.NewExprNode(inner, "time.Parse(time.RFC3339, ", ")", types.Typ[types.Int64], true)
+	assert.Equal(t, "time.Parse(time.RFC3339, src)", parse.AssignExpr())
+	assert.True(t, parse.ReturnsError())
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"go/types"
 
+	"github.com/reedom/convergen/pkg/option"
 	"github.com/reedom/convergen/pkg/util"
 )
 
@@ -13,6 +14,8 @@ type StructFieldNode struct {
 	parent Node
 	// field refers to the leaf Field.
 	field *types.Var
+	// getter is the name of the method that reads the field, such as GetName of a protobuf message, or empty.
+	getter string
 }
 
 // NewStructFieldNode creates a new StructFieldNode.
@@ -23,6 +26,15 @@ func NewStructFieldNode(container Node, field *types.Var) StructFieldNode {
 	}
 }
 
+// NewStructFieldGetterNode creates a new StructFieldNode that reads the field through the getter.
+func NewStructFieldGetterNode(container Node, field *types.Var, getter string) StructFieldNode {
+	return StructFieldNode{
+		parent: container,
+		field:  field,
+		getter: getter,
+	}
+}
+
 // Parent returns the container of the node or nil.
 func (n StructFieldNode) Parent() Node {
 	return n.parent
@@ -53,7 +65,11 @@ func (n StructFieldNode) ReturnsError() bool {
 
 // AssignExpr returns a value evaluate expression for assignment.
 // For example, it returns "dst.User.Name", "dst.User.Status()", "strconv.Itoa(dst.User.Score())", etc.
+// A field with a getter is read through it, such as "src.User.GetName()".
 func (n StructFieldNode) AssignExpr() string {
+	if n.getter != "" {
+		return fmt.Sprintf("%v.%v()", n.parent.AssignExpr(), n.getter)
+	}
 	return fmt.Sprintf("%v.%v", n.parent.AssignExpr(), n.field.Name())
 }
 
@@ -70,7 +86,29 @@ func (n StructFieldNode) MatcherExpr() string {
 // NullCheckExpr returns a value evaluate expression for null check conditional.
 // For example, it returns "dst.Node.Child".
 func (n StructFieldNode) NullCheckExpr() string {
-	return fmt.Sprintf("%v.%v", n.parent.AssignExpr(), n.field.Name())
+	return n.AssignExpr()
+}
+
+// Tag returns the value associated with key in the struct tag of the field.
+// It returns false if the tag is absent, empty or "-".
+func (n StructFieldNode) Tag(key string) (string, bool) {
+	return util.LookupFieldTag(n.parent.ExprType(), n.field, key)
+}
+
+// Field returns the struct field.
+func (n StructFieldNode) Field() *types.Var {
+	return n.field
+}
+
+// Directives returns the directives in the `convergen` struct tag of the field.
+// A malformed tag is treated as empty; the parser reports it beforehand.
+func (n StructFieldNode) Directives() option.FieldTag {
+	value, ok := util.LookupRawFieldTag(n.parent.ExprType(), n.field, option.TagKey)
+	if !ok {
+		return option.FieldTag{}
+	}
+	tag, _ := option.ParseFieldTag(value)
+	return tag
 }
 
 // StructMethodNode represents a struct method.
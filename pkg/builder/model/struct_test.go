@@ -29,6 +29,23 @@ func TestStructFieldNode(t *testing.T) {
 	assert.Equal(t, "dst.MyField", fieldNode.NullCheckExpr())
 }
 
+func TestStructFieldGetterNode(t *testing.T) {
+	parent := model.NewRootNode("src", types.NewPointer(types.NewNamed(
+		types.NewTypeName(0, nil, "MyMessage", nil),
+		nil,
+		nil,
+	)))
+	field := types.NewField(0, nil, "Child", types.NewPointer(types.Typ[types.String]), false)
+	fieldNode := model.NewStructFieldGetterNode(parent, field, "GetChild")
+
+	assert.Equal(t, "Child", fieldNode.ObjName())
+	assert.True(t, fieldNode.ObjNullable())
+	assert.Equal(t, field, fieldNode.Field())
+	assert.Equal(t, "src.GetChild()", fieldNode.AssignExpr())
+	assert.Equal(t, "Child", fieldNode.MatcherExpr())
+	assert.Equal(t, "src.GetChild()", fieldNode.NullCheckExpr())
+}
+
 func TestStructMethodNode(t *testing.T) {
 	// Create a parent node.
 	parent := model.NewRootNode("dst", types.NewPointer(types.NewNamed(
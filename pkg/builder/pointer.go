@@ -0,0 +1,74 @@
+package builder
+
+import (
+	"go/types"
+
+	bmodel "github.com/reedom/convergen/pkg/builder/model"
+	gmodel "github.com/reedom/convergen/pkg/generator/model"
+	"github.com/reedom/convergen/pkg/option"
+	"github.com/reedom/convergen/pkg/util"
+)
+
+// castFunc turns rhs into a node of lhsType, such as castNode does.
+type castFunc func(lhsType types.Type, rhs bmodel.Node) (bmodel.Node, bool, error)
+
+// converterCast returns a castFunc that applies the converter.
+func (b *assignmentBuilder) converterCast(converter *option.FieldConverter) castFunc {
+	return func(lhsType types.Type, rhs bmodel.Node) (bmodel.Node, bool, error) {
+		c, err := b.convertNode(lhsType, rhs, converter)
+		return c, c != nil, err
+	}
+}
+
+// bridgeAssignment creates an assignment of rhs to lhs that cast cannot bridge by itself,
+// by sqlNullAssignment, protoAssignment or ptrAssignment, whichever applies first.
+func (b *assignmentBuilder) bridgeAssignment(lhs, rhs bmodel.Node, cast castFunc) (gmodel.Assignment, bool, error) {
+	if a, ok, err := b.sqlNullAssignment(lhs, rhs, cast); ok || err != nil {
+		return a, ok, err
+	}
+	if a, ok, err := b.protoAssignment(lhs, rhs, cast); ok || err != nil {
+		return a, ok, err
+	}
+	return b.ptrAssignment(lhs, rhs, cast)
+}
+
+// ptrAssignment creates an assignment of rhs to lhs where either or both of them are pointers that
+// cast cannot bridge by itself, such as *T to T, T to *T and *T to *U.
+// A pointer source is dereferenced inside a nil check, and a pointer destination is allocated.
+// A pair of structs, such as *T to U, is left to the nested struct handling.
+func (b *assignmentBuilder) ptrAssignment(lhs, rhs bmodel.Node, cast castFunc) (gmodel.Assignment, bool, error) {
+	if !b.opts.Ptr {
+		return nil, false, nil
+	}
+
+	lhsType, rhsType := lhs.ExprType(), rhs.ExprType()
+	lhsPtr, rhsPtr := util.IsPtr(lhsType), util.IsPtr(rhsType)
+	if !lhsPtr && !rhsPtr ||
+		util.IsStructType(util.DerefPtr(lhsType)) && util.IsStructType(util.DerefPtr(rhsType)) {
+		return nil, false, nil
+	}
+
+	a := gmodel.PointerAssignment{LHS: lhs.AssignExpr()}
+	src := rhs
+	if rhsPtr {
+		src = bmodel.NewDerefNode(rhs)
+		a.NullCheck = rhs.AssignExpr()
+	}
+	if lhsPtr {
+		lhsType = util.DerefPtr(lhsType)
+		a.Alloc = b.imports.TypeName(lhsType)
+	}
+
+	c, ok, err := cast(lhsType, src)
+	if !ok || err != nil {
+		return nil, false, err
+	}
+	a.RHS, a.Error = c.AssignExpr(), c.ReturnsError()
+	return a, true, nil
+}
+
+// isValuePtr reports whether t is a pointer to other than a struct.
+func isValuePtr(t types.Type) bool {
+	elem, ok := util.Deref(t)
+	return ok && !util.IsStructType(elem)
+}
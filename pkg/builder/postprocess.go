@@ -13,8 +13,11 @@ import (
 // option, source and destination variables, and retError.
 // It checks that the function is valid and the types of its arguments match
 // the source and destination variables.
+// The other parameters of the function receive the arguments of the generated function of the same types.
 // If the Manipulator is nil, it returns nil and no error.
-func (p *FunctionBuilder) buildManipulator(m *option.Manipulator, src *types.Var, dst *types.Var, retError bool) (*gmodel.Manipulator, error) {
+func (p *FunctionBuilder) buildManipulator(
+	m *option.Manipulator, src *types.Var, dst *types.Var, args []funcArg, retError bool,
+) (*gmodel.Manipulator, error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -43,5 +46,14 @@ func (p *FunctionBuilder) buildManipulator(m *option.Manipulator, src *types.Var
 	ret.IsSrcPtr = util.IsPtr(m.SrcSide)
 	ret.IsDstPtr = util.IsPtr(m.DstSide)
 
+	var err error
+	ret.LeadingArgs, err = matchArgs(m.LeadingParams, args)
+	if err == nil {
+		ret.TrailingArgs, err = matchArgs(m.TrailingParams, args)
+	}
+	if err != nil {
+		return nil, logger.Errorf("%v: postprocess function %v: %v", p.fset.Position(m.Pos), ret.FuncName(), err)
+	}
+
 	return ret, nil
 }
@@ -0,0 +1,372 @@
+package builder
+
+import (
+	"fmt"
+	"go/types"
+	"sort"
+	"strings"
+
+	bmodel "github.com/reedom/convergen/pkg/builder/model"
+	gmodel "github.com/reedom/convergen/pkg/generator/model"
+	"github.com/reedom/convergen/pkg/logger"
+	"github.com/reedom/convergen/pkg/util"
+)
+
+// Paths of the packages that declare the well-known types of protobuf.
+const (
+	wrapperspbPath  = "google.golang.org/protobuf/types/known/wrapperspb"
+	timestamppbPath = "google.golang.org/protobuf/types/known/timestamppb"
+	durationpbPath  = "google.golang.org/protobuf/types/known/durationpb"
+)
+
+// oneofVar is the variable bound to the member of a oneof field in a type switch.
+const oneofVar = "v"
+
+// protoValue describes a well-known type of protobuf that holds a native value,
+// such as *wrapperspb.StringValue for string and *timestamppb.Timestamp for time.Time.
+type protoValue struct {
+	getter string     // getter is the method that returns the native value, such as "AsTime".
+	ctor   string     // ctor is the function that creates a message from the native value, such as "timestamppb.New".
+	value  types.Type // value is the native value type.
+}
+
+// isProtoInternalField returns true if name is an internal field of protoc-gen-go messages,
+// including the XXX_ fields of the older generator.
+func isProtoInternalField(name string) bool {
+	switch name {
+	case "state", "sizeCache", "unknownFields":
+		return true
+	}
+	return strings.HasPrefix(name, "XXX_")
+}
+
+// isProtoMessage returns true if t is a struct, or a pointer to it, that has fields with the protobuf struct tags
+// as protoc-gen-go generates.
+func isProtoMessage(t types.Type) (found bool) {
+	util.IterateFields(t, func(f *types.Var) (done bool) {
+		_, found = util.LookupRawFieldTag(t, f, "protobuf")
+		if !found {
+			_, found = util.LookupRawFieldTag(t, f, "protobuf_oneof")
+		}
+		return found
+	})
+	return
+}
+
+// isOneofField returns true if node is a oneof field of a protobuf message.
+func isOneofField(node bmodel.Node) bool {
+	field, ok := node.(bmodel.StructFieldNode)
+	if !ok {
+		return false
+	}
+	_, ok = util.LookupRawFieldTag(field.Parent().ExprType(), field.Field(), "protobuf_oneof")
+	return ok
+}
+
+// iterateSourceFields iterates through the fields of the source struct like bmodel.IterateStructFields does.
+// In the proto mode, a field of a protobuf message is read through its getter if any, such as GetName() for Name.
+func (b *assignmentBuilder) iterateSourceFields(structNode bmodel.Node, cb func(bmodel.Node) (done bool)) {
+	if !b.opts.Proto || !isProtoMessage(structNode.ExprType()) {
+		bmodel.IterateStructFields(structNode, cb)
+		return
+	}
+
+	getters := map[string]types.Type{}
+	bmodel.IterateStructMethods(structNode, func(m bmodel.Node) (done bool) {
+		getters[m.ObjName()] = m.ExprType()
+		return
+	})
+	util.IterateFields(structNode.ExprType(), func(f *types.Var) (done bool) {
+		getter := "Get" + f.Name()
+		if t, ok := getters[getter]; ok && types.Identical(t, f.Type()) {
+			return cb(bmodel.NewStructFieldGetterNode(structNode, f, getter))
+		}
+		return cb(bmodel.NewStructFieldNode(structNode, f))
+	})
+}
+
+// protoValueOf returns the description of t if t is a pointer to a well-known type of protobuf
+// that holds a native value.
+func (b *assignmentBuilder) protoValueOf(t types.Type) (protoValue, bool) {
+	elem, ok := util.Deref(t)
+	named, isNamed := elem.(*types.Named)
+	if !ok || !isNamed || named.Obj().Pkg() == nil {
+		return protoValue{}, false
+	}
+
+	pkg, name := named.Obj().Pkg(), named.Obj().Name()
+	var getter, ctor string
+	switch {
+	case pkg.Path() == wrapperspbPath && strings.HasSuffix(name, "Value"):
+		getter, ctor = "GetValue", strings.TrimSuffix(name, "Value")
+	case pkg.Path() == timestamppbPath && name == "Timestamp":
+		getter, ctor = "AsTime", "New"
+	case pkg.Path() == durationpbPath && name == "Duration":
+		getter, ctor = "AsDuration", "New"
+	default:
+		return protoValue{}, false
+	}
+
+	obj, _, _ := types.LookupFieldOrMethod(t, false, pkg, getter)
+	fn, ok := obj.(*types.Func)
+	if !ok || !util.CompliesGetter(fn) {
+		return protoValue{}, false
+	}
+	value := fn.Type().(*types.Signature).Results().At(0).Type()
+	return protoValue{getter: getter, ctor: b.importName(pkg.Path()) + "." + ctor, value: value}, true
+}
+
+// protoNode creates a message of lhsType, a well-known type of protobuf, from rhs of its native value,
+// such as timestamppb.New(src.CreatedAt).
+func (b *assignmentBuilder) protoNode(lhsType types.Type, rhs bmodel.Node) (bmodel.Node, bool, error) {
+	pv, ok := b.protoValueOf(lhsType)
+	if !ok {
+		return nil, false, nil
+	}
+
+	arg, ok, err := b.castNode(pv.value, rhs)
+	if !ok || err != nil || arg.ReturnsError() {
+		return nil, false, err
+	}
+	return bmodel.NewExprNode(arg, pv.ctor+"(", ")", lhsType, false), true, nil
+}
+
+// protoAssignment creates an assignment between a well-known type of protobuf and its native value that
+// cast cannot bridge by itself, such as *timestamppb.Timestamp to time.Time or *time.Time, and *string to
+// *wrapperspb.StringValue.
+// A nil message leaves the destination as it is, rather than the value that its getter returns for nil.
+func (b *assignmentBuilder) protoAssignment(lhs, rhs bmodel.Node, cast castFunc) (gmodel.Assignment, bool, error) {
+	if !b.opts.Proto {
+		return nil, false, nil
+	}
+
+	lhsType, rhsType := lhs.ExprType(), rhs.ExprType()
+	if pv, ok := b.protoValueOf(rhsType); ok {
+		a := gmodel.PointerAssignment{LHS: lhs.AssignExpr(), NullCheck: rhs.AssignExpr()}
+		if elem, ok := util.Deref(lhsType); ok {
+			if !b.isNameable(elem) {
+				return nil, false, nil
+			}
+			lhsType = elem
+			a.Alloc = b.imports.TypeName(elem)
+		}
+
+		c, ok, err := cast(lhsType, bmodel.NewExprNode(rhs, "", "."+pv.getter+"()", pv.value, false))
+		if !ok || err != nil {
+			return nil, false, err
+		}
+		a.RHS, a.Error = c.AssignExpr(), c.ReturnsError()
+		return a, true, nil
+	}
+
+	// A non-pointer source is left to castNode.
+	if _, ok := b.protoValueOf(lhsType); !ok || !util.IsPtr(rhsType) {
+		return nil, false, nil
+	}
+	c, ok, err := cast(lhsType, bmodel.NewDerefNode(rhs))
+	if !ok || err != nil {
+		return nil, false, err
+	}
+	a := gmodel.PointerAssignment{LHS: lhs.AssignExpr(), NullCheck: rhs.AssignExpr()}
+	a.RHS, a.Error = c.AssignExpr(), c.ReturnsError()
+	return a, true, nil
+}
+
+// oneofToField creates an assignment of lhs from a member of a oneof field of rhsStructs,
+// such as Email of *pb.User_Email in the Contact field.
+// The members of a oneof field are assigned in a single type switch on the field. The switch is returned
+// for the first member, and the later ones add their cases to it and return nil.
+func (b *assignmentBuilder) oneofToField(lhs bmodel.Node, rhsStructs []bmodel.Node) (a gmodel.Assignment, ok bool, err error) {
+	for _, rhsStruct := range rhsStructs {
+		b.iterateSourceFields(rhsStruct, func(oneof bmodel.Node) (done bool) {
+			if !isOneofField(oneof) || !b.isStructFieldAccessible(rhsStruct, oneof.ObjName()) {
+				return
+			}
+			for _, wrapper := range oneofWrappers(oneof.ExprType()) {
+				wrapperType := types.NewPointer(wrapper)
+				member := wrapper.Underlying().(*types.Struct).Field(0)
+				rhs := bmodel.NewStructFieldNode(bmodel.NewRootNode(oneofVar, wrapperType), member)
+				if !b.isNameable(wrapper) || !b.compareFields(lhs, rhs) {
+					continue
+				}
+
+				var inner gmodel.Assignment
+				var found bool
+				if inner, found, err = b.oneofMemberAssignment(lhs, rhs); err != nil {
+					return true
+				}
+				if !found {
+					logger.Warnf("%v: %v of %v doesn't fit %v [%v]", b.fset.Position(b.methodPos),
+						member.Name(), oneof.AssignExpr(), lhs.AssignExpr(), b.imports.TypeName(lhs.ExprType()))
+					continue
+				}
+				b.consume(oneof)
+				logger.Printf("%v: assignment found: %v = %v", b.fset.Position(b.methodPos), lhs.AssignExpr(), oneof.AssignExpr())
+				a, ok = b.addOneofCase(oneof, b.imports.TypeName(wrapperType), inner), true
+				return true
+			}
+			return
+		})
+		if ok || err != nil {
+			return
+		}
+	}
+	return nil, false, nil
+}
+
+// oneofMemberAssignment creates an assignment of lhs from rhs, the member in the wrapper type of a oneof field.
+func (b *assignmentBuilder) oneofMemberAssignment(lhs, rhs bmodel.Node) (gmodel.Assignment, bool, error) {
+	c, ok, err := b.castNode(lhs.ExprType(), rhs)
+	if err != nil {
+		return nil, false, err
+	}
+	if ok {
+		return gmodel.SimpleField{LHS: lhs.AssignExpr(), RHS: c.AssignExpr(), Error: c.ReturnsError()}, true, nil
+	}
+	if a, ok, err := b.protoAssignment(lhs, rhs, b.castNode); ok || err != nil {
+		return a, ok, err
+	}
+	if a, ok, err := b.ptrAssignment(lhs, rhs, b.castNode); ok || err != nil {
+		return a, ok, err
+	}
+	// A helper function would copy a message by value.
+	if util.IsStructType(util.DerefPtr(lhs.ExprType())) && util.IsStructType(util.DerefPtr(rhs.ExprType())) &&
+		!isProtoMessage(lhs.ExprType()) && !isProtoMessage(rhs.ExprType()) {
+		a, err := b.structCopy(lhs, rhs)
+		return a, a != nil, err
+	}
+	return nil, false, nil
+}
+
+// addOneofCase adds the assignment to the case of the wrapper type in the type switch on the oneof field.
+// It returns the switch if it is new, or nil if it has been returned for another member.
+func (b *assignmentBuilder) addOneofCase(oneof bmodel.Node, wrapperType string, a gmodel.Assignment) gmodel.Assignment {
+	key := oneof.AssignExpr()
+	sw, exists := b.oneofs[key]
+	if !exists {
+		sw = &gmodel.SwitchAssignment{Var: oneofVar, Expr: key}
+		b.oneofs[key] = sw
+	}
+
+	var c *gmodel.SwitchCase
+	for _, existing := range sw.Cases {
+		if existing.Cond == wrapperType {
+			c = existing
+			break
+		}
+	}
+	if c == nil {
+		c = &gmodel.SwitchCase{Cond: wrapperType}
+		sw.Cases = append(sw.Cases, c)
+	}
+	c.Assignments = append(c.Assignments, a)
+
+	if exists {
+		return nil
+	}
+	return sw
+}
+
+// fieldToOneof creates an assignment of the oneof field lhs from the fields of rhsStructs that match its members,
+// such as Email of the source to Email of *pb.User_Email.
+// It assigns the first member whose source is not nil, so that only pointer sources can be members.
+func (b *assignmentBuilder) fieldToOneof(lhs bmodel.Node, rhsStructs []bmodel.Node) (gmodel.Assignment, bool, error) {
+	sw := &gmodel.SwitchAssignment{}
+	for _, wrapper := range oneofWrappers(lhs.ExprType()) {
+		member := wrapper.Underlying().(*types.Struct).Field(0)
+		memberNode := bmodel.NewStructFieldNode(bmodel.NewRootNode("", wrapper), member)
+		if !b.isNameable(wrapper) {
+			continue
+		}
+
+		rhs, ok := b.lookupOneofMemberSource(memberNode, rhsStructs)
+		if !ok {
+			continue
+		}
+		if !rhs.ObjNullable() {
+			logger.Warnf("%v: %v cannot be assigned to %v of %v since it cannot be nil",
+				b.fset.Position(b.methodPos), rhs.AssignExpr(), member.Name(), lhs.AssignExpr())
+			continue
+		}
+
+		c, ok, err := b.castNode(member.Type(), rhs)
+		if !ok && err == nil {
+			c, ok, err = b.castNode(member.Type(), bmodel.NewDerefNode(rhs))
+		}
+		if err != nil {
+			return nil, false, err
+		}
+		if !ok || c.ReturnsError() {
+			logger.Warnf("%v: %v doesn't fit %v of %v [%v]", b.fset.Position(b.methodPos),
+				rhs.AssignExpr(), member.Name(), lhs.AssignExpr(), b.imports.TypeName(member.Type()))
+			continue
+		}
+
+		b.consume(rhs)
+		rhsExpr := fmt.Sprintf("&%v{%v: %v}", b.imports.TypeName(wrapper), member.Name(), c.AssignExpr())
+		sw.Cases = append(sw.Cases, &gmodel.SwitchCase{
+			Cond:        rhs.NullCheckExpr() + " != nil",
+			Assignments: []gmodel.Assignment{gmodel.SimpleField{LHS: lhs.AssignExpr(), RHS: rhsExpr}},
+		})
+	}
+
+	if len(sw.Cases) == 0 {
+		return nil, false, nil
+	}
+	logger.Printf("%v: assignment found: %v", b.fset.Position(b.methodPos), lhs.AssignExpr())
+	return sw, true, nil
+}
+
+// lookupOneofMemberSource looks up the field of rhsStructs that matches the member of a oneof field.
+func (b *assignmentBuilder) lookupOneofMemberSource(member bmodel.Node, rhsStructs []bmodel.Node) (rhs bmodel.Node, ok bool) {
+	for _, rhsStruct := range rhsStructs {
+		b.iterateSourceFields(rhsStruct, func(node bmodel.Node) (done bool) {
+			if fieldDirectives(node).Skip ||
+				!b.isStructFieldAccessible(rhsStruct, node.ObjName()) ||
+				!b.compareFields(member, node) {
+				return
+			}
+			rhs, ok = node, true
+			return true
+		})
+		if ok {
+			return
+		}
+	}
+	return
+}
+
+// oneofWrappers returns the wrapper types of the members of a oneof field of type t,
+// which are the structs of a single field declared in the same package whose pointers implement t,
+// such as User_Email for isUser_Contact. They are in the order of declaration.
+func oneofWrappers(t types.Type) []*types.Named {
+	named, ok := t.(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return nil
+	}
+	iface, ok := named.Underlying().(*types.Interface)
+	if !ok || iface.NumMethods() == 0 {
+		return nil
+	}
+
+	var wrappers []*types.Named
+	scope := named.Obj().Pkg().Scope()
+	for _, name := range scope.Names() {
+		obj, ok := scope.Lookup(name).(*types.TypeName)
+		if !ok || obj.IsAlias() {
+			continue
+		}
+		wrapper, ok := obj.Type().(*types.Named)
+		if !ok {
+			continue
+		}
+		if st, ok := wrapper.Underlying().(*types.Struct); ok && st.NumFields() == 1 &&
+			types.Implements(types.NewPointer(wrapper), iface) {
+			wrappers = append(wrappers, wrapper)
+		}
+	}
+	sort.Slice(wrappers, func(i, j int) bool {
+		return wrappers[i].Obj().Pos() < wrappers[j].Obj().Pos()
+	})
+	return wrappers
+}
@@ -0,0 +1,59 @@
+package builder
+
+import (
+	"go/types"
+
+	bmodel "github.com/reedom/convergen/pkg/builder/model"
+	gmodel "github.com/reedom/convergen/pkg/generator/model"
+	"github.com/reedom/convergen/pkg/util"
+)
+
+// sliceElemCopy attempts to create a slice assignment that copies the struct elements of rhs to lhs,
+// by another method being generated together or by a helper function.
+// The elements of either or both of them may be pointers. It returns nil if they cannot be copied.
+func (b *assignmentBuilder) sliceElemCopy(lhs, rhs bmodel.Node, lhsElem, rhsElem types.Type) (gmodel.Assignment, error) {
+	lhsStruct, lhsPtr := util.Deref(lhsElem)
+	rhsStruct, rhsPtr := util.Deref(rhsElem)
+	if !util.IsStructType(lhsStruct) || !util.IsStructType(rhsStruct) || (lhsPtr != rhsPtr && !b.opts.Ptr) {
+		return nil, nil
+	}
+
+	a := gmodel.SliceElemAssignment{
+		LHS:     lhs.AssignExpr(),
+		RHS:     rhs.AssignExpr(),
+		Typ:     b.imports.TypeName(lhs.ExprType()),
+		ElemTyp: b.imports.TypeName(lhsElem),
+		SkipNil: rhsPtr && b.opts.SkipNil,
+	}
+	elem := gmodel.PointerAssignment{LHS: lhs.AssignExpr() + "[i]"}
+	if a.SkipNil {
+		elem.LHS = "d"
+	} else if rhsPtr {
+		elem.NullCheck = "e"
+	}
+
+	src := bmodel.NewScalarNode(nil, "e", rhsElem)
+	if c, ok := b.siblingNode(lhsElem, src); ok {
+		elem.RHS, elem.Error = c.AssignExpr(), c.ReturnsError()
+		a.Elem = elem
+		return a, nil
+	}
+
+	if !b.isNameable(lhsStruct) || !b.isNameable(rhsStruct) {
+		return nil, nil
+	}
+	h, err := b.owner.helperFor(b, lhsStruct, rhsStruct)
+	if err != nil {
+		return nil, err
+	}
+	if rhsPtr {
+		src = bmodel.NewDerefNode(src)
+	}
+	if lhsPtr {
+		elem.Alloc = b.imports.TypeName(lhsStruct)
+	}
+	call := bmodel.NewConverterNode(src, h.converter)
+	elem.RHS, elem.Error = call.AssignExpr(), call.ReturnsError()
+	a.Elem = elem
+	return a, nil
+}
@@ -0,0 +1,85 @@
+package builder
+
+import (
+	"go/types"
+	"strings"
+
+	bmodel "github.com/reedom/convergen/pkg/builder/model"
+	gmodel "github.com/reedom/convergen/pkg/generator/model"
+	"github.com/reedom/convergen/pkg/util"
+)
+
+// sqlNullNode wraps rhs in lhsType, one of the Null types of database/sql, as a valid value,
+// such as sql.NullString{String: src.Name, Valid: true}.
+func (b *assignmentBuilder) sqlNullNode(lhsType types.Type, rhs bmodel.Node) (bmodel.Node, bool, error) {
+	field := sqlNullField(lhsType)
+	if field == nil || !b.isNameable(lhsType) {
+		return nil, false, nil
+	}
+
+	// An error-returning value cannot be a part of the composite literal.
+	inner, ok, err := b.castNode(field.Type(), rhs)
+	if !ok || err != nil || inner.ReturnsError() {
+		return nil, false, err
+	}
+	return bmodel.NewSQLNullNode(inner, lhsType, b.imports.TypeName(lhsType), field.Name()), true, nil
+}
+
+// sqlNullAssignment creates an assignment between a Null type of database/sql and its value that
+// cast cannot bridge by itself, such as sql.NullString to string or *string, and *string to sql.NullString.
+// A Null source is read only if it is valid, and a nil pointer source leaves the Null destination invalid.
+func (b *assignmentBuilder) sqlNullAssignment(lhs, rhs bmodel.Node, cast castFunc) (gmodel.Assignment, bool, error) {
+	if !b.opts.SQLNull {
+		return nil, false, nil
+	}
+
+	lhsType, rhsType := lhs.ExprType(), rhs.ExprType()
+	if field := sqlNullField(rhsType); field != nil {
+		a := gmodel.ValidAssignment{LHS: lhs.AssignExpr(), Valid: rhs.AssignExpr() + ".Valid"}
+		if elem, ok := util.Deref(lhsType); ok {
+			if !b.isNameable(elem) {
+				return nil, false, nil
+			}
+			lhsType = elem
+			a.Alloc = b.imports.TypeName(elem)
+		}
+
+		c, ok, err := cast(lhsType, bmodel.NewStructFieldNode(rhs, field))
+		if !ok || err != nil {
+			return nil, false, err
+		}
+		a.RHS, a.Error = c.AssignExpr(), c.ReturnsError()
+		return a, true, nil
+	}
+
+	// A non-pointer source is left to castNode.
+	if sqlNullField(lhsType) == nil || !util.IsPtr(rhsType) {
+		return nil, false, nil
+	}
+	c, ok, err := cast(lhsType, bmodel.NewDerefNode(rhs))
+	if !ok || err != nil {
+		return nil, false, err
+	}
+	a := gmodel.PointerAssignment{LHS: lhs.AssignExpr(), NullCheck: rhs.AssignExpr()}
+	a.RHS, a.Error = c.AssignExpr(), c.ReturnsError()
+	return a, true, nil
+}
+
+// sqlNullField returns the value field of t if t is one of the Null types of database/sql,
+// such as String of sql.NullString and V of sql.Null[T].
+func sqlNullField(t types.Type) *types.Var {
+	named, ok := t.(*types.Named)
+	if !ok {
+		return nil
+	}
+	obj := named.Obj()
+	if obj.Pkg() == nil || obj.Pkg().Path() != "database/sql" || !strings.HasPrefix(obj.Name(), "Null") {
+		return nil
+	}
+
+	st, ok := named.Underlying().(*types.Struct)
+	if !ok || st.NumFields() != 2 || st.Field(1).Name() != "Valid" {
+		return nil
+	}
+	return st.Field(0)
+}
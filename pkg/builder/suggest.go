@@ -0,0 +1,119 @@
+package builder
+
+import (
+	"fmt"
+	"go/types"
+	"sort"
+	"strings"
+
+	bmodel "github.com/reedom/convergen/pkg/builder/model"
+	"github.com/reedom/convergen/pkg/util"
+)
+
+// maxSuggestions is the maximum number of source members listed for an unassigned destination field.
+const maxSuggestions = 3
+
+// Kinds of suggestions, in the order of relevance.
+const (
+	suggestType    = iota // The name matches, but the type or the tag doesn't.
+	suggestGetter         // The name matches a getter, but getters are disabled.
+	suggestCase           // The name differs only in case.
+	suggestSimilar        // The name is within a small edit distance.
+)
+
+// suggestion represents a source member that might be meant for an unassigned destination field.
+type suggestion struct {
+	expr     string // expr is the expression of the source member.
+	note     string // note explains why it didn't match.
+	kind     int    // kind is the kind of the suggestion.
+	distance int    // distance is the edit distance between the names.
+}
+
+// String returns the suggestion in the form of "expr (note)".
+func (s suggestion) String() string {
+	return fmt.Sprintf("%v (%v)", s.expr, s.note)
+}
+
+// suggestSources returns a "did you mean" clause that lists the members of rhsStructs closest to lhs,
+// or an empty string if there are none.
+func (b *assignmentBuilder) suggestSources(lhs bmodel.Node, rhsStructs []bmodel.Node) string {
+	var found []suggestion
+	for _, rhsStruct := range rhsStructs {
+		visit := func(getter bool) func(bmodel.Node) bool {
+			return func(rhs bmodel.Node) (done bool) {
+				if fieldDirectives(rhs).Skip || !b.isStructFieldAccessible(rhsStruct, rhs.ObjName()) {
+					return
+				}
+				if s, ok := b.suggest(lhs, rhs, getter); ok {
+					found = append(found, s)
+				}
+				return
+			}
+		}
+		bmodel.IterateStructMethods(rhsStruct, visit(true))
+		bmodel.IterateStructFields(rhsStruct, visit(false))
+	}
+	if len(found) == 0 {
+		return ""
+	}
+
+	sort.SliceStable(found, func(i, j int) bool {
+		if found[i].kind != found[j].kind {
+			return found[i].kind < found[j].kind
+		}
+		return found[i].distance < found[j].distance
+	})
+	if maxSuggestions < len(found) {
+		found = found[:maxSuggestions]
+	}
+	list := make([]string, len(found))
+	for i, s := range found {
+		list[i] = s.String()
+	}
+	return ", did you mean " + strings.Join(list, ", ") + "?"
+}
+
+// suggest examines whether rhs might be meant for lhs.
+func (b *assignmentBuilder) suggest(lhs, rhs bmodel.Node, getter bool) (suggestion, bool) {
+	lhsName, rhsName := lhs.ObjName(), rhs.ObjName()
+	s := suggestion{expr: rhs.AssignExpr()}
+
+	matched := b.compareFields(lhs, rhs)
+	switch {
+	case matched || b.opts.CompareFieldName(lhsName, rhsName):
+		switch {
+		case getter && !b.opts.Getter:
+			s.kind, s.note = suggestGetter, "a getter; use :getter"
+		case !matched:
+			s.kind, s.note = suggestType, fmt.Sprintf("not paired by the %v tag; use :map", b.opts.TagKey())
+		case types.AssignableTo(rhs.ExprType(), lhs.ExprType()):
+			s.kind, s.note = suggestType, "ambiguous; use :map"
+		default:
+			s.kind, s.note = suggestType, b.castFailure(lhs.ExprType(), rhs.ExprType())
+		}
+	case strings.EqualFold(lhsName, rhsName):
+		s.kind, s.note = suggestCase, "differs in case; use :case:off"
+	default:
+		s.distance = util.EditDistance(strings.ToLower(lhsName), strings.ToLower(rhsName))
+		if len(lhsName)/3+1 < s.distance {
+			return s, false
+		}
+		s.kind, s.note = suggestSimilar, "similar name"
+	}
+	return s, true
+}
+
+// castFailure explains why a value of rhsType cannot be assigned to lhsType and how to get it done.
+func (b *assignmentBuilder) castFailure(lhsType, rhsType types.Type) string {
+	reason := fmt.Sprintf("%v is not assignable to %v", b.imports.TypeName(rhsType), b.imports.TypeName(lhsType))
+	switch {
+	case !b.opts.Ptr && (isValuePtr(lhsType) || isValuePtr(rhsType)):
+		return reason + "; use :ptr"
+	case !b.opts.Typecast && types.ConvertibleTo(rhsType, lhsType):
+		return reason + "; use :typecast"
+	case !b.opts.Stringer && types.AssignableTo(util.StringType(), lhsType) && util.CompliesStringer(rhsType):
+		return reason + "; use :stringer"
+	default:
+		return reason + "; use :conv or :conv:type"
+	}
+}
@@ -0,0 +1,108 @@
+package builder
+
+import (
+	"fmt"
+	"go/types"
+	"strconv"
+	"time"
+
+	bmodel "github.com/reedom/convergen/pkg/builder/model"
+	"github.com/reedom/convergen/pkg/logger"
+	"github.com/reedom/convergen/pkg/util"
+)
+
+// timeNode converts rhs to lhsType in the format of the :time notation, where either of them is
+// time.Time or time.Duration and the other is an integer or a string.
+// time.Time is converted from and to the Unix time or a formatted string, and time.Duration is
+// converted from and to seconds, milliseconds or a string such as "1m30s".
+func (b *assignmentBuilder) timeNode(lhsType types.Type, rhs bmodel.Node) (bmodel.Node, bool, error) {
+	if util.IsTimeType(lhsType) || util.IsDurationType(lhsType) {
+		return b.parseTimeNode(lhsType, rhs)
+	}
+
+	f, pkg := b.opts.Time, b.timePkg()
+	rhsType := rhs.ExprType()
+	int64Type := types.Typ[types.Int64]
+
+	var c bmodel.Node
+	switch {
+	case util.IsTimeType(rhsType) && f.Unit() == "unix" && isInteger(lhsType):
+		c = bmodel.NewExprNode(rhs, "", ".Unix()", int64Type, false)
+	case util.IsTimeType(rhsType) && f.Unit() == "unixmilli" && isInteger(lhsType):
+		c = bmodel.NewExprNode(rhs, "", ".UnixMilli()", int64Type, false)
+	case util.IsTimeType(rhsType) && f.Layout() != "" && isString(lhsType):
+		c = bmodel.NewExprNode(rhs, "", fmt.Sprintf(".Format(%v)", b.timeLayout()), util.StringType(), false)
+	case util.IsDurationType(rhsType) && f.Unit() == "unix" && isInteger(lhsType):
+		c = bmodel.NewExprNode(rhs, "int64(", fmt.Sprintf(" / %v.Second)", pkg), int64Type, false)
+	case util.IsDurationType(rhsType) && f.Unit() == "unixmilli" && isInteger(lhsType):
+		c = bmodel.NewExprNode(rhs, "", ".Milliseconds()", int64Type, false)
+	case util.IsDurationType(rhsType) && isString(lhsType):
+		c = bmodel.NewExprNode(rhs, "", ".String()", util.StringType(), false)
+	default:
+		return nil, false, nil
+	}
+	return b.castNode(lhsType, c)
+}
+
+// parseTimeNode converts rhs to lhsType, which is either time.Time or time.Duration.
+// Parsing a string returns an error, so that it is available only if the function returns an error.
+func (b *assignmentBuilder) parseTimeNode(lhsType types.Type, rhs bmodel.Node) (bmodel.Node, bool, error) {
+	f, pkg := b.opts.Time, b.timePkg()
+	rhsType := rhs.ExprType()
+
+	var prefix, suffix string
+	var argType types.Type
+	switch {
+	case util.IsTimeType(lhsType) && f.Unit() == "unix" && isInteger(rhsType):
+		prefix, suffix, argType = pkg+".Unix(", ", 0)", types.Typ[types.Int64]
+	case util.IsTimeType(lhsType) && f.Unit() == "unixmilli" && isInteger(rhsType):
+		prefix, suffix, argType = pkg+".UnixMilli(", ")", types.Typ[types.Int64]
+	case util.IsTimeType(lhsType) && f.Layout() != "" && isString(rhsType):
+		prefix, suffix, argType = fmt.Sprintf("%v.Parse(%v, ", pkg, b.timeLayout()), ")", util.StringType()
+	case util.IsDurationType(lhsType) && f.Unit() == "unix" && isInteger(rhsType):
+		prefix, suffix, argType = pkg+".Duration(", fmt.Sprintf(") * %v.Second", pkg), types.Typ[types.Int64]
+	case util.IsDurationType(lhsType) && f.Unit() == "unixmilli" && isInteger(rhsType):
+		prefix, suffix, argType = pkg+".Duration(", fmt.Sprintf(") * %v.Millisecond", pkg), types.Typ[types.Int64]
+	case util.IsDurationType(lhsType) && isString(rhsType):
+		prefix, suffix, argType = pkg+".ParseDuration(", ")", util.StringType()
+	default:
+		return nil, false, nil
+	}
+
+	arg, ok, err := b.castNode(argType, rhs)
+	if !ok || err != nil || arg.ReturnsError() {
+		return nil, false, err
+	}
+	retErr := isString(rhsType)
+	if retErr && !b.retError {
+		logger.Warnf("%v: cannot parse %v into %v since the function does not return an error",
+			b.fset.Position(b.methodPos), rhs.AssignExpr(), b.imports.TypeName(lhsType))
+		return nil, false, nil
+	}
+	return bmodel.NewExprNode(arg, prefix, suffix, lhsType, retErr), true, nil
+}
+
+// timePkg returns the name by which the generated code refers to the time package.
+func (b *assignmentBuilder) timePkg() string {
+	return b.importName("time")
+}
+
+// timeLayout returns the expression of the layout in the format of the :time notation.
+func (b *assignmentBuilder) timeLayout() string {
+	if layout := b.opts.Time.Layout(); layout != time.RFC3339 {
+		return strconv.Quote(layout)
+	}
+	return b.timePkg() + ".RFC3339"
+}
+
+// isInteger returns true if the underlying type of t is an integer type.
+func isInteger(t types.Type) bool {
+	basic, ok := t.Underlying().(*types.Basic)
+	return ok && basic.Info()&types.IsInteger != 0
+}
+
+// isString returns true if the underlying type of t is string.
+func isString(t types.Type) bool {
+	basic, ok := t.Underlying().(*types.Basic)
+	return ok && basic.Info()&types.IsString != 0
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"path/filepath"
 	"strings"
 )
 
@@ -39,6 +40,42 @@ type Config struct {
 	Stringer bool
 	// Whether to use getter methods to access fields
 	Getter bool
+	// Whether to fail generation on unmatched destination fields
+	Strict bool
+	// Tags are the build tags to load the input file with, in addition to "convergen".
+	Tags []string
+	// Header is the comment put at the top of the generated code.
+	Header string
+	// File is the project configuration file, or nil if there is none.
+	File *File
+}
+
+// LoadFile reads the project configuration file at path and applies its settings to the config.
+func (c *Config) LoadFile(path string) error {
+	f, err := ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	if f.Case != nil {
+		c.ExactCase = *f.Case
+	}
+	if f.Cast != nil {
+		c.Typecast = *f.Cast
+	}
+	if f.Stringer != nil {
+		c.Stringer = *f.Stringer
+	}
+	if f.Getter != nil {
+		c.Getter = *f.Getter
+	}
+	if f.Strict != nil {
+		c.Strict = *f.Strict
+	}
+	c.Tags = f.Tags
+	c.Header = f.Header
+	c.File = f
+	return nil
 }
 
 // String returns the string representation of the config.
@@ -55,9 +92,11 @@ func (c *Config) String() string {
 }
 
 // ParseArgs parses the command line arguments.
+// Settings in the project configuration file take effect unless the corresponding flags are given.
 func (c *Config) ParseArgs() error {
 	output := flag.String("out", "", "Set the output file path")
 	outputSuffix := flag.String("suffix", "", "Set the output suffix file path")
+	configPath := flag.String("config", "", "Set the project configuration file path (default: "+FileName+" found upward from the input file)")
 	logs := flag.Bool("log", false, "Write log messages to <output path>.log.")
 	dryRun := flag.Bool("dry", false, "Perform a dry run without writing files.")
 	prints := flag.Bool("print", false, "Print the resulting code to STDOUT as well.")
@@ -65,6 +104,7 @@ func (c *Config) ParseArgs() error {
 	typecast := flag.Bool("cast", false, "Whether to use explicit typecasts when converting values")
 	stringer := flag.Bool("stringer", false, "Whether to use stringer methods to convert values to strings")
 	getter := flag.Bool("getter", false, "Whether to use getter methods to access fields")
+	strict := flag.Bool("strict", false, "Whether to fail generation on unmatched destination fields")
 
 	flag.Usage = Usage
 	flag.Parse()
@@ -79,6 +119,37 @@ func (c *Config) ParseArgs() error {
 	}
 	c.Input = inputPath
 
+	c.ExactCase = *exactCase
+	c.Typecast = *typecast
+	c.Stringer = *stringer
+	c.Getter = *getter
+	c.Strict = *strict
+
+	if *configPath == "" {
+		*configPath, _ = FindFile(filepath.Dir(inputPath))
+	}
+	if *configPath != "" {
+		if err := c.LoadFile(*configPath); err != nil {
+			return err
+		}
+	}
+
+	// Flags given explicitly win over the project configuration file.
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "case":
+			c.ExactCase = *exactCase
+		case "cast":
+			c.Typecast = *typecast
+		case "stringer":
+			c.Stringer = *stringer
+		case "getter":
+			c.Getter = *getter
+		case "strict":
+			c.Strict = *strict
+		}
+	})
+
 	if *output != "" {
 		c.Output = *output
 	} else {
@@ -86,6 +157,8 @@ func (c *Config) ParseArgs() error {
 		suffix := ".gen"
 		if outputSuffix != nil && *outputSuffix != "" {
 			suffix = "." + *outputSuffix
+		} else if c.File != nil && c.File.Suffix != "" {
+			suffix = "." + c.File.Suffix
 		}
 		c.Output = inputPath[0:len(inputPath)-len(ext)] + suffix + ext
 	}
@@ -96,10 +169,6 @@ func (c *Config) ParseArgs() error {
 	}
 	c.DryRun = *dryRun
 	c.Prints = *prints
-	c.ExactCase = *exactCase
-	c.Typecast = *typecast
-	c.Stringer = *stringer
-	c.Getter = *getter
 
 	return nil
 }
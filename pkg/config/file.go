@@ -0,0 +1,95 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FileName is the name of the project configuration file.
+const FileName = "convergen.yaml"
+
+// File represents the contents of a project configuration file.
+type File struct {
+	// Path is the path of the file.
+	Path string `yaml:"-"`
+	// Source is the raw contents of the file.
+	Source []byte `yaml:"-"`
+	// Notations are the defaults of the interface-level notations, such as ":match tag json".
+	Notations []Notation `yaml:"notations"`
+	// Tags are the build tags to load the input file with, in addition to "convergen".
+	Tags []string `yaml:"tags"`
+	// Suffix is the suffix of the output file name, such as "gen" for "<basename>.gen.go".
+	Suffix string `yaml:"suffix"`
+	// Header is the comment put at the top of the generated code.
+	Header string `yaml:"header"`
+	// Strict is the default of whether to fail generation on unmatched destination fields.
+	Strict *bool `yaml:"strict"`
+	// Case is the default of the -case flag.
+	Case *bool `yaml:"case"`
+	// Cast is the default of the -cast flag.
+	Cast *bool `yaml:"cast"`
+	// Stringer is the default of the -stringer flag.
+	Stringer *bool `yaml:"stringer"`
+	// Getter is the default of the -getter flag.
+	Getter *bool `yaml:"getter"`
+}
+
+// Notation represents a notation in a project configuration file.
+type Notation struct {
+	// Text is the notation, such as ":match tag json".
+	Text string
+	// Line is the line number of the notation in the file.
+	Line int
+	// Column is the column number of the notation in the file.
+	Column int
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (n *Notation) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind != yaml.ScalarNode || !strings.HasPrefix(node.Value, ":") {
+		return fmt.Errorf("line %v: a notation must be a string that starts with \":\"", node.Line)
+	}
+	n.Text = node.Value
+	n.Line = node.Line
+	n.Column = node.Column
+	return nil
+}
+
+// FindFile looks for a project configuration file from dir up to the root directory.
+func FindFile(dir string) (path string, ok bool) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", false
+	}
+
+	for {
+		path = filepath.Join(dir, FileName)
+		if stat, err := os.Stat(path); err == nil && !stat.IsDir() {
+			return path, true
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", false
+		}
+		dir = parent
+	}
+}
+
+// ReadFile reads a project configuration file.
+func ReadFile(path string) (*File, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &File{Path: path, Source: src}
+	if err = yaml.Unmarshal(src, f); err != nil {
+		return nil, fmt.Errorf("%v: %w", path, err)
+	}
+	return f, nil
+}
@@ -0,0 +1,66 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/reedom/convergen/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindFile(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	sub := filepath.Join(root, "a", "b")
+	require.Nil(t, os.MkdirAll(sub, 0o755))
+	require.Nil(t, os.WriteFile(filepath.Join(root, config.FileName), nil, 0o644))
+
+	path, ok := config.FindFile(sub)
+	assert.True(t, ok)
+	assert.Equal(t, filepath.Join(root, config.FileName), path)
+}
+
+func TestConfig_LoadFile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), config.FileName)
+	src := `notations:
+  - ":match tag json"
+  - ":getter"
+tags: [integration]
+suffix: conv
+header: Copyright
+strict: true
+cast: true
+`
+	require.Nil(t, os.WriteFile(path, []byte(src), 0o644))
+
+	c := config.Config{ExactCase: true}
+	require.Nil(t, c.LoadFile(path))
+
+	assert.True(t, c.ExactCase)
+	assert.True(t, c.Typecast)
+	assert.False(t, c.Getter)
+	assert.True(t, c.Strict)
+	assert.Equal(t, []string{"integration"}, c.Tags)
+	assert.Equal(t, "Copyright", c.Header)
+	assert.Equal(t, "conv", c.File.Suffix)
+
+	require.Len(t, c.File.Notations, 2)
+	assert.Equal(t, ":match tag json", c.File.Notations[0].Text)
+	assert.Equal(t, 2, c.File.Notations[0].Line)
+	assert.Equal(t, 5, c.File.Notations[0].Column)
+}
+
+func TestConfig_LoadFileInvalidNotation(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), config.FileName)
+	require.Nil(t, os.WriteFile(path, []byte("notations:\n  - match tag\n"), 0o644))
+
+	c := config.Config{}
+	assert.ErrorContains(t, c.LoadFile(path), `starts with ":"`)
+}
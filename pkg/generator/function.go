@@ -53,18 +53,26 @@ func (g *Generator) FuncToString(f *model.Function) string {
 		}
 	}
 
-	if f.Receiver == "" {
+	params := f.Params
+	if params == nil && f.Receiver == "" {
+		params = []model.Var{f.Src}
+	}
+	for i, v := range params {
+		if 0 < i || (f.DstVarStyle == model.DstVarArg && f.Receiver != "") {
+			// "func (r *SrcModel) Name(dst *DstModel, "
+			sb.WriteString(", ")
+		}
 		// "func Name(dst *DstModel, src *SrcModel"
-		sb.WriteString(f.Src.Name)
+		sb.WriteString(v.Name)
 		sb.WriteString(" ")
-		sb.WriteString(f.Src.FullType())
+		sb.WriteString(v.FullType())
 	}
 	// "func Name(dst *DstModel, src *SrcModel)"
 	sb.WriteString(") ")
 
 	checkSrc := func() {
-		if f.Src.Pointer {
-			sb.WriteString(fmt.Sprintf("if %s == nil {\n", f.Src.Name))
+		if cond := nilCheckExpr(f); cond != "" {
+			sb.WriteString(fmt.Sprintf("if %s {\n", cond))
 			sb.WriteString("return\n")
 			sb.WriteString("}\n\n")
 		}
@@ -142,3 +150,24 @@ func (g *Generator) FuncToString(f *model.Function) string {
 	sb.WriteString("\n")
 	return sb.String()
 }
+
+// nilCheckExpr returns the condition on which the function returns without copying anything, or empty if there is none.
+// With more than one source, every source is checked for nil in the assignments, so that the function returns
+// early only if all of them are nil.
+func nilCheckExpr(f *model.Function) string {
+	if len(f.Sources) < 2 {
+		if f.Src.Pointer {
+			return fmt.Sprintf("%s == nil", f.Src.Name)
+		}
+		return ""
+	}
+
+	conds := make([]string, 0, len(f.Sources))
+	for _, src := range f.Sources {
+		if !src.Pointer {
+			return ""
+		}
+		conds = append(conds, fmt.Sprintf("%s == nil", src.Name))
+	}
+	return strings.Join(conds, " && ")
+}
@@ -77,6 +77,12 @@ func (g *Generator) generateContent() (content []byte, err error) {
 	}
 
 	buf := bytes.Buffer{}
+	if g.code.Header != "" {
+		_, err = buf.WriteString(headerComment(g.code.Header))
+		if err != nil {
+			return
+		}
+	}
 	_, err = buf.WriteString("// Code generated by github.com/reedom/convergen\n// DO NOT EDIT.\n\n")
 	if err == nil {
 		_, err = buf.WriteString(code)
@@ -87,3 +93,18 @@ func (g *Generator) generateContent() (content []byte, err error) {
 
 	return buf.Bytes(), nil
 }
+
+// headerComment returns the header text as line comments followed by a blank line.
+// Lines that are already comments are kept as they are.
+func headerComment(header string) string {
+	var sb strings.Builder
+	for _, line := range strings.Split(strings.TrimRight(header, "\n"), "\n") {
+		if !strings.HasPrefix(strings.TrimSpace(line), "//") {
+			line = strings.TrimRight("// "+line, " ")
+		}
+		sb.WriteString(line)
+		sb.WriteString("\n")
+	}
+	sb.WriteString("\n")
+	return sb.String()
+}
@@ -356,3 +356,18 @@ func ToModel(src *domain.Pet) (dst *model.Pet, err error) {
 		})
 	}
 }
+
+func TestGenerator_Header(t *testing.T) {
+	t.Parallel()
+
+	code := model.Code{
+		Header:   "Copyright 2026 Example Inc.\n\n// SPDX-License-Identifier: MIT\n",
+		BaseCode: "package simple\n",
+	}
+	g := generator.NewGenerator(code)
+	actual, err := g.Generate("temp.gen.go", false, true)
+	if assert.Nil(t, err) {
+		expected := "// Copyright 2026 Example Inc.\n//\n// SPDX-License-Identifier: MIT\n\n" + header + "package simple\n"
+		assert.Equal(t, expected, string(actual))
+	}
+}
@@ -25,6 +25,10 @@ func (g *Generator) ManipulatorToString(m *model.Manipulator, src, dst model.Var
 	}
 	sb.WriteString(m.Name)
 	sb.WriteString("(")
+	for _, arg := range m.LeadingArgs {
+		sb.WriteString(arg)
+		sb.WriteString(", ")
+	}
 
 	if dst.Pointer != m.IsDstPtr {
 		if dst.Pointer {
@@ -44,6 +48,10 @@ func (g *Generator) ManipulatorToString(m *model.Manipulator, src, dst model.Var
 		}
 	}
 	sb.WriteString(src.Name)
+	for _, arg := range m.TrailingArgs {
+		sb.WriteString(", ")
+		sb.WriteString(arg)
+	}
 	sb.WriteString(")\n")
 
 	if m.RetError {
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"strconv"
 	"strings"
 )
 
@@ -120,6 +121,70 @@ func (s SimpleField) RetError() bool {
 	return s.Error
 }
 
+// PointerAssignment represents an assignment between a pointer and a non-pointer type.
+// It guards a pointer source against nil and allocates a pointer destination.
+type PointerAssignment struct {
+	LHS       string // LHS is the destination field.
+	RHS       string // RHS is the value to assign, in which the source pointer is dereferenced.
+	NullCheck string // NullCheck is the source pointer to check against nil, or empty if the source isn't a pointer.
+	Alloc     string // Alloc is the element type to allocate for the destination pointer, or empty if it isn't a pointer.
+	Error     bool
+}
+
+// String returns the string representation of the pointer assignment.
+func (s PointerAssignment) String() string {
+	var sb strings.Builder
+	if s.NullCheck != "" {
+		sb.WriteString("if ")
+		sb.WriteString(s.NullCheck)
+		sb.WriteString(" != nil {\n")
+	}
+	lhs := s.LHS
+	if s.Alloc != "" {
+		sb.WriteString(s.LHS)
+		sb.WriteString(" = new(")
+		sb.WriteString(s.Alloc)
+		sb.WriteString(")\n")
+		lhs = "*" + s.LHS
+	}
+	sb.WriteString(SimpleField{LHS: lhs, RHS: s.RHS, Error: s.Error}.String())
+	if s.NullCheck != "" {
+		sb.WriteString("}\n")
+	}
+	return sb.String()
+}
+
+// RetError returns whether the assignment returns an error value.
+func (s PointerAssignment) RetError() bool {
+	return s.Error
+}
+
+// ValidAssignment represents an assignment from a Null type of database/sql, such as sql.NullString.
+// It assigns the value only if the source is valid, and allocates a pointer destination.
+type ValidAssignment struct {
+	LHS   string // LHS is the destination field.
+	RHS   string // RHS is the value to assign, which reads the value field of the source.
+	Valid string // Valid is the Valid field of the source to check.
+	Alloc string // Alloc is the element type to allocate for the destination pointer, or empty if it isn't a pointer.
+	Error bool
+}
+
+// String returns the string representation of the valid assignment.
+func (s ValidAssignment) String() string {
+	var sb strings.Builder
+	sb.WriteString("if ")
+	sb.WriteString(s.Valid)
+	sb.WriteString(" {\n")
+	sb.WriteString(PointerAssignment{LHS: s.LHS, RHS: s.RHS, Alloc: s.Alloc, Error: s.Error}.String())
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// RetError returns whether the assignment returns an error value.
+func (s ValidAssignment) RetError() bool {
+	return s.Error
+}
+
 // NestStruct represents a struct in a struct.
 type NestStruct struct {
 	InitExpr      string
@@ -217,11 +282,13 @@ func (c SliceLoopAssignment) RetError() bool {
 
 // SliceTypecastAssignment represents a slice assignment with a typecast.
 type SliceTypecastAssignment struct {
-	LHS   string
-	RHS   string
-	Typ   string
-	Cast  string
-	Error bool
+	LHS          string
+	RHS          string
+	Typ          string
+	Cast         string
+	Error        bool
+	LeadingArgs  []string // LeadingArgs is the list of arguments of Cast that precede the element.
+	TrailingArgs []string // TrailingArgs is the list of arguments of Cast that follow the element.
 }
 
 // String returns the string representation of the slice assignment with a typecast.
@@ -245,7 +312,17 @@ func (c SliceTypecastAssignment) String() string {
 	}
 	sb.WriteString(" = ")
 	sb.WriteString(c.Cast)
-	sb.WriteString("(e)\n")
+	sb.WriteString("(")
+	for _, arg := range c.LeadingArgs {
+		sb.WriteString(arg)
+		sb.WriteString(", ")
+	}
+	sb.WriteString("e")
+	for _, arg := range c.TrailingArgs {
+		sb.WriteString(", ")
+		sb.WriteString(arg)
+	}
+	sb.WriteString(")\n")
 	if c.Error {
 		sb.WriteString("if err != nil {\nreturn\n}")
 	}
@@ -258,6 +335,226 @@ func (c SliceTypecastAssignment) RetError() bool {
 	return false
 }
 
+// MapAssignment represents a map assignment that copies the entries into a new map.
+// Key and Value are the expressions of the destination key and value in terms of "k" and "v"
+// that range over the source map.
+type MapAssignment struct {
+	LHS        string
+	RHS        string
+	Typ        string // Typ is the type of the destination map.
+	Key        string
+	KeyTyp     string // KeyTyp is the type of the destination key, to declare it when Key returns an error.
+	KeyError   bool
+	Value      string
+	ValueTyp   string // ValueTyp is the type of the destination value, to declare it when Value returns an error.
+	ValueError bool
+}
+
+// String returns the string representation of the map assignment.
+func (c MapAssignment) String() string {
+	var sb strings.Builder
+	sb.WriteString("if ")
+	sb.WriteString(c.RHS)
+	sb.WriteString(" != nil {\n")
+	sb.WriteString(c.LHS)
+	sb.WriteString(" = make(")
+	sb.WriteString(c.Typ)
+	sb.WriteString(", len(")
+	sb.WriteString(c.RHS)
+	sb.WriteString("))\nfor k, v := range ")
+	sb.WriteString(c.RHS)
+	sb.WriteString(" {\n")
+	key := c.mapElem(&sb, "dk", c.Key, c.KeyTyp, c.KeyError)
+	value := c.mapElem(&sb, "dv", c.Value, c.ValueTyp, c.ValueError)
+	sb.WriteString(c.LHS)
+	sb.WriteString("[")
+	sb.WriteString(key)
+	sb.WriteString("] = ")
+	sb.WriteString(value)
+	sb.WriteString("\n}\n}\n")
+	return sb.String()
+}
+
+// mapElem writes the statements to evaluate expr into a variable if expr returns an error,
+// and returns the expression to use for the element.
+func (c MapAssignment) mapElem(sb *strings.Builder, name, expr, typ string, retError bool) string {
+	if !retError {
+		return expr
+	}
+	sb.WriteString("var ")
+	sb.WriteString(name)
+	sb.WriteString(" ")
+	sb.WriteString(typ)
+	sb.WriteString("\n")
+	sb.WriteString(name)
+	sb.WriteString(", err = ")
+	sb.WriteString(expr)
+	sb.WriteString("\nif err != nil {\nreturn\n}\n")
+	return name
+}
+
+// RetError returns whether the assignment returns an error value.
+func (c MapAssignment) RetError() bool {
+	return false
+}
+
+// SliceElemAssignment represents a slice assignment that copies each element of the source by Elem.
+// Elem assigns the source element "e" to the destination element, which is "LHS[i]",
+// or "d" that is appended to LHS if SkipNil is set.
+type SliceElemAssignment struct {
+	LHS     string
+	RHS     string
+	Typ     string     // Typ is the type of the destination slice.
+	Elem    Assignment // Elem is the assignment of an element.
+	ElemTyp string     // ElemTyp is the type of the destination element, to declare "d" with.
+	SkipNil bool       // SkipNil drops nil elements of the source rather than leaving zero values in their places.
+}
+
+// String returns the string representation of the slice assignment.
+func (c SliceElemAssignment) String() string {
+	var sb strings.Builder
+	sb.WriteString("if ")
+	sb.WriteString(c.RHS)
+	sb.WriteString(" != nil {\n")
+	sb.WriteString(c.LHS)
+	sb.WriteString(" = make(")
+	sb.WriteString(c.Typ)
+	if c.SkipNil {
+		sb.WriteString(", 0")
+	}
+	sb.WriteString(", len(")
+	sb.WriteString(c.RHS)
+	sb.WriteString("))\n")
+	if c.SkipNil {
+		sb.WriteString("for _, e := range ")
+		sb.WriteString(c.RHS)
+		sb.WriteString(" {\nif e == nil {\ncontinue\n}\n")
+		sb.WriteString(c.declareElem())
+	} else {
+		sb.WriteString("for i, e := range ")
+		sb.WriteString(c.RHS)
+		sb.WriteString(" {\n")
+		sb.WriteString(c.Elem.String())
+	}
+	if c.Elem.RetError() {
+		sb.WriteString("if err != nil {\nreturn\n}\n")
+	}
+	if c.SkipNil {
+		sb.WriteString(c.LHS)
+		sb.WriteString(" = append(")
+		sb.WriteString(c.LHS)
+		sb.WriteString(", d)\n")
+	}
+	sb.WriteString("}\n}\n")
+	return sb.String()
+}
+
+// declareElem returns the statements that declare "d" and assign the element to it.
+// "d" is declared by := unless the element assignment returns an error, since "d, err :=" would shadow err.
+func (c SliceElemAssignment) declareElem() string {
+	var lhs, rhs, alloc string
+	var retErr bool
+	switch e := c.Elem.(type) {
+	case SimpleField:
+		lhs, rhs, retErr = e.LHS, e.RHS, e.Error
+	case PointerAssignment:
+		if e.NullCheck == "" {
+			lhs, rhs, alloc, retErr = e.LHS, e.RHS, e.Alloc, e.Error
+		}
+	}
+
+	var sb strings.Builder
+	switch {
+	case lhs != "" && alloc != "":
+		// "d := new(T)" and "*d = ..."
+		sb.WriteString(lhs)
+		sb.WriteString(" := new(")
+		sb.WriteString(alloc)
+		sb.WriteString(")\n")
+		sb.WriteString(SimpleField{LHS: "*" + lhs, RHS: rhs, Error: retErr}.String())
+	case lhs != "" && !retErr:
+		// "d := ..."
+		sb.WriteString(lhs)
+		sb.WriteString(" := ")
+		sb.WriteString(rhs)
+		sb.WriteString("\n")
+	default:
+		// "var d T" and "d, err = ..."
+		sb.WriteString("var d ")
+		sb.WriteString(c.ElemTyp)
+		sb.WriteString("\n")
+		sb.WriteString(c.Elem.String())
+	}
+	return sb.String()
+}
+
+// RetError returns whether the assignment returns an error value.
+func (c SliceElemAssignment) RetError() bool {
+	return false
+}
+
+// ArrayAssignment represents an assignment between arrays, or between an array and a slice.
+// Elem is the expression of the destination element in terms of "e" that ranges over the source.
+type ArrayAssignment struct {
+	LHS       string
+	RHS       string
+	Typ       string // Typ is the type of the destination slice to make, or empty if the destination is an array.
+	Elem      string
+	ElemError bool
+	CheckLen  bool // CheckLen fails with an error unless the lengths of the source and the destination are the same.
+}
+
+// String returns the string representation of the array assignment.
+func (c ArrayAssignment) String() string {
+	var sb strings.Builder
+	if c.Typ != "" {
+		sb.WriteString(c.LHS)
+		sb.WriteString(" = make(")
+		sb.WriteString(c.Typ)
+		sb.WriteString(", len(")
+		sb.WriteString(c.RHS)
+		sb.WriteString("))\n")
+	}
+	if c.CheckLen {
+		sb.WriteString("if len(")
+		sb.WriteString(c.RHS)
+		sb.WriteString(") != len(")
+		sb.WriteString(c.LHS)
+		sb.WriteString(") {\nerr = fmt.Errorf(")
+		sb.WriteString(strconv.Quote(c.RHS + " has %d elements, but " + c.LHS + " has %d"))
+		sb.WriteString(", len(")
+		sb.WriteString(c.RHS)
+		sb.WriteString("), len(")
+		sb.WriteString(c.LHS)
+		sb.WriteString("))\n} else {\n")
+	}
+	sb.WriteString("for i, e := range ")
+	sb.WriteString(c.RHS)
+	sb.WriteString(" {\n")
+	sb.WriteString(c.LHS)
+	sb.WriteString("[i]")
+	if c.ElemError {
+		sb.WriteString(", err")
+	}
+	sb.WriteString(" = ")
+	sb.WriteString(c.Elem)
+	sb.WriteString("\n")
+	if c.ElemError {
+		sb.WriteString("if err != nil {\nreturn\n}\n")
+	}
+	sb.WriteString("}\n")
+	if c.CheckLen {
+		sb.WriteString("}\n")
+	}
+	return sb.String()
+}
+
+// RetError returns whether the assignment returns an error value.
+// It does when the lengths are checked, to return the error of a mismatch.
+func (c ArrayAssignment) RetError() bool {
+	return c.CheckLen
+}
+
 // SliceMethodCallAssignment represents a slice assignment with a typecast.
 type SliceMethodCallAssignment struct {
 	LHS      string
@@ -311,6 +608,7 @@ func (c SliceMethodCallAssignment) RetError() bool {
 // IfAssignment represents if check assignment
 type IfAssignment struct {
 	Inner    Assignment
+	Else     Assignment // Else is the assignment to take if Expr is nil. Can be nil.
 	Nullable bool
 	Expr     string
 }
@@ -326,6 +624,10 @@ func (s IfAssignment) String() string {
 	sb.WriteString(s.Expr)
 	sb.WriteString(" != nil {\n")
 	sb.WriteString(s.Inner.String())
+	if s.Else != nil {
+		sb.WriteString("} else {\n")
+		sb.WriteString(s.Else.String())
+	}
 	sb.WriteString("}\n")
 
 	return sb.String()
@@ -333,5 +635,73 @@ func (s IfAssignment) String() string {
 
 // RetError returns whether the assignment returns an error value.
 func (s IfAssignment) RetError() bool {
-	return s.Inner.RetError()
+	return s.Inner.RetError() || (s.Else != nil && s.Else.RetError())
+}
+
+// SwitchAssignment represents a switch statement whose cases hold assignments.
+// With Var, it switches on the dynamic type of Expr, such as a oneof field of protobuf.
+// With Expr alone, each case is a value of Expr. Otherwise, each case is a boolean condition.
+type SwitchAssignment struct {
+	Var     string        // Var is the variable bound to the value of Expr in each case of a type switch, or empty.
+	Expr    string        // Expr is the value to switch on, or empty.
+	Cases   []*SwitchCase // Cases are the cases in order. They can grow after the assignment is created.
+	Default []Assignment  // Default is the assignments in the default case, if any.
+}
+
+// SwitchCase represents a case of a switch statement.
+type SwitchCase struct {
+	Cond        string // Cond is either the type, the value or the condition of the case.
+	Assignments []Assignment
+}
+
+// String returns the string representation of the switch assignment.
+func (s *SwitchAssignment) String() string {
+	var sb strings.Builder
+	switch {
+	case s.Var != "":
+		sb.WriteString("switch ")
+		sb.WriteString(s.Var)
+		sb.WriteString(" := ")
+		sb.WriteString(s.Expr)
+		sb.WriteString(".(type) {\n")
+	case s.Expr != "":
+		sb.WriteString("switch ")
+		sb.WriteString(s.Expr)
+		sb.WriteString(" {\n")
+	default:
+		sb.WriteString("switch {\n")
+	}
+	for _, c := range s.Cases {
+		sb.WriteString("case ")
+		sb.WriteString(c.Cond)
+		sb.WriteString(":\n")
+		for _, a := range c.Assignments {
+			sb.WriteString(a.String())
+		}
+	}
+	if s.Default != nil {
+		sb.WriteString("default:\n")
+		for _, a := range s.Default {
+			sb.WriteString(a.String())
+		}
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// RetError returns whether the assignment returns an error value.
+func (s *SwitchAssignment) RetError() bool {
+	for _, c := range s.Cases {
+		for _, a := range c.Assignments {
+			if a.RetError() {
+				return true
+			}
+		}
+	}
+	for _, a := range s.Default {
+		if a.RetError() {
+			return true
+		}
+	}
+	return false
 }
@@ -64,6 +64,54 @@ func TestSimpleField(t *testing.T) {
 	})
 }
 
+func TestPointerAssignment(t *testing.T) {
+	t.Parallel()
+
+	t.Run("deref", func(t *testing.T) {
+		pa := model.PointerAssignment{LHS: "dst.Name", RHS: "*src.Name", NullCheck: "src.Name"}
+		expected := `if src.Name != nil {
+dst.Name = *src.Name
+}
+`
+		assert.Equal(t, expected, pa.String())
+		require.False(t, pa.RetError())
+	})
+
+	t.Run("alloc", func(t *testing.T) {
+		pa := model.PointerAssignment{LHS: "dst.Score", RHS: "parse(src.Score)", Alloc: "int", Error: true}
+		expected := `dst.Score = new(int)
+*dst.Score, err = parse(src.Score)
+`
+		assert.Equal(t, expected, pa.String())
+		require.True(t, pa.RetError())
+	})
+}
+
+func TestValidAssignment(t *testing.T) {
+	t.Parallel()
+
+	t.Run("value", func(t *testing.T) {
+		va := model.ValidAssignment{LHS: "dst.Name", RHS: "src.Name.String", Valid: "src.Name.Valid"}
+		expected := `if src.Name.Valid {
+dst.Name = src.Name.String
+}
+`
+		assert.Equal(t, expected, va.String())
+		require.False(t, va.RetError())
+	})
+
+	t.Run("alloc", func(t *testing.T) {
+		va := model.ValidAssignment{LHS: "dst.Score", RHS: "parse(src.Score.Int64)", Valid: "src.Score.Valid", Alloc: "int", Error: true}
+		expected := `if src.Score.Valid {
+dst.Score = new(int)
+*dst.Score, err = parse(src.Score.Int64)
+}
+`
+		assert.Equal(t, expected, va.String())
+		require.True(t, va.RetError())
+	})
+}
+
 func TestNestStruct(t *testing.T) {
 	t.Parallel()
 	ns := model.NestStruct{
@@ -144,3 +192,255 @@ foo[i] = string(e)
 		require.False(t, actual)
 	})
 }
+
+func TestMapAssignment(t *testing.T) {
+	t.Parallel()
+
+	t.Run("String", func(t *testing.T) {
+		ma := model.MapAssignment{
+			LHS:   "dst.Scores",
+			RHS:   "src.Scores",
+			Typ:   "map[string]int64",
+			Key:   "k",
+			Value: "int64(v)",
+		}
+		expected := `if src.Scores != nil {
+dst.Scores = make(map[string]int64, len(src.Scores))
+for k, v := range src.Scores {
+dst.Scores[k] = int64(v)
+}
+}
+`
+		assert.Equal(t, expected, ma.String())
+		require.False(t, ma.RetError())
+	})
+
+	t.Run("String with error", func(t *testing.T) {
+		ma := model.MapAssignment{
+			LHS:      "dst.Scores",
+			RHS:      "src.Scores",
+			Typ:      "map[int]string",
+			Key:      "parse(k)",
+			KeyTyp:   "int",
+			KeyError: true,
+			Value:    "v",
+			ValueTyp: "string",
+		}
+		expected := `if src.Scores != nil {
+dst.Scores = make(map[int]string, len(src.Scores))
+for k, v := range src.Scores {
+var dk int
+dk, err = parse(k)
+if err != nil {
+return
+}
+dst.Scores[dk] = v
+}
+}
+`
+		assert.Equal(t, expected, ma.String())
+	})
+}
+
+func TestSliceElemAssignment(t *testing.T) {
+	t.Parallel()
+
+	t.Run("keep nil", func(t *testing.T) {
+		sa := model.SliceElemAssignment{
+			LHS:  "dst.Items",
+			RHS:  "src.Items",
+			Typ:  "[]storage.Item",
+			Elem: model.PointerAssignment{LHS: "dst.Items[i]", RHS: "itemToStorageItem(*e)", NullCheck: "e"},
+		}
+		expected := `if src.Items != nil {
+dst.Items = make([]storage.Item, len(src.Items))
+for i, e := range src.Items {
+if e != nil {
+dst.Items[i] = itemToStorageItem(*e)
+}
+}
+}
+`
+		assert.Equal(t, expected, sa.String())
+		require.False(t, sa.RetError())
+	})
+
+	t.Run("skip nil", func(t *testing.T) {
+		sa := model.SliceElemAssignment{
+			LHS:     "dst.Items",
+			RHS:     "src.Items",
+			Typ:     "[]storage.Item",
+			Elem:    model.SimpleField{LHS: "d", RHS: "itemToStorageItem(*e)", Error: true},
+			ElemTyp: "storage.Item",
+			SkipNil: true,
+		}
+		expected := `if src.Items != nil {
+dst.Items = make([]storage.Item, 0, len(src.Items))
+for _, e := range src.Items {
+if e == nil {
+continue
+}
+var d storage.Item
+d, err = itemToStorageItem(*e)
+if err != nil {
+return
+}
+dst.Items = append(dst.Items, d)
+}
+}
+`
+		assert.Equal(t, expected, sa.String())
+	})
+
+	t.Run("skip nil without error", func(t *testing.T) {
+		sa := model.SliceElemAssignment{
+			LHS:     "dst.Items",
+			RHS:     "src.Items",
+			Typ:     "[]*storage.Item",
+			Elem:    model.PointerAssignment{LHS: "d", RHS: "itemToStorageItem(*e)", Alloc: "storage.Item"},
+			ElemTyp: "*storage.Item",
+			SkipNil: true,
+		}
+		expected := `if src.Items != nil {
+dst.Items = make([]*storage.Item, 0, len(src.Items))
+for _, e := range src.Items {
+if e == nil {
+continue
+}
+d := new(storage.Item)
+*d = itemToStorageItem(*e)
+dst.Items = append(dst.Items, d)
+}
+}
+`
+		assert.Equal(t, expected, sa.String())
+		require.False(t, sa.RetError())
+	})
+}
+
+func TestArrayAssignment(t *testing.T) {
+	t.Parallel()
+
+	t.Run("array to slice", func(t *testing.T) {
+		aa := model.ArrayAssignment{
+			LHS:  "dst.Vector",
+			RHS:  "src.Vector",
+			Typ:  "[]float32",
+			Elem: "float32(e)",
+		}
+		expected := `dst.Vector = make([]float32, len(src.Vector))
+for i, e := range src.Vector {
+dst.Vector[i] = float32(e)
+}
+`
+		assert.Equal(t, expected, aa.String())
+		require.False(t, aa.RetError())
+	})
+
+	t.Run("slice to array", func(t *testing.T) {
+		aa := model.ArrayAssignment{
+			LHS:       "dst.Vector",
+			RHS:       "src.Vector",
+			Elem:      "parse(e)",
+			ElemError: true,
+			CheckLen:  true,
+		}
+		expected := `if len(src.Vector) != len(dst.Vector) {
+err = fmt.Errorf("src.Vector has %d elements, but dst.Vector has %d", len(src.Vector), len(dst.Vector))
+} else {
+for i, e := range src.Vector {
+dst.Vector[i], err = parse(e)
+if err != nil {
+return
+}
+}
+}
+`
+		assert.Equal(t, expected, aa.String())
+		require.True(t, aa.RetError())
+	})
+}
+
+func TestIfAssignment(t *testing.T) {
+	t.Parallel()
+	ia := model.IfAssignment{
+		Inner:    model.SimpleField{LHS: "dst.Name", RHS: "profile.Name"},
+		Else:     model.SimpleField{LHS: "dst.Name", RHS: "user.Name", Error: true},
+		Nullable: true,
+		Expr:     "profile",
+	}
+
+	t.Run("String", func(t *testing.T) {
+		expected := `if profile != nil {
+dst.Name = profile.Name
+} else {
+dst.Name, err = user.Name
+}
+`
+		actual := ia.String()
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("RetError", func(t *testing.T) {
+		actual := ia.RetError()
+		require.True(t, actual)
+	})
+}
+
+func TestSwitchAssignment(t *testing.T) {
+	t.Parallel()
+
+	t.Run("type switch", func(t *testing.T) {
+		sa := &model.SwitchAssignment{
+			Var:  "v",
+			Expr: "src.GetContact()",
+			Cases: []*model.SwitchCase{
+				{Cond: "*pb.User_Email", Assignments: []model.Assignment{model.SimpleField{LHS: "dst.Email", RHS: "v.Email"}}},
+				{Cond: "*pb.User_Phone", Assignments: []model.Assignment{model.SimpleField{LHS: "dst.Phone", RHS: "parse(v.Phone)", Error: true}}},
+			},
+		}
+		expected := `switch v := src.GetContact().(type) {
+case *pb.User_Email:
+dst.Email = v.Email
+case *pb.User_Phone:
+dst.Phone, err = parse(v.Phone)
+}
+`
+		assert.Equal(t, expected, sa.String())
+		require.True(t, sa.RetError())
+	})
+
+	t.Run("conditions", func(t *testing.T) {
+		sa := &model.SwitchAssignment{
+			Cases: []*model.SwitchCase{
+				{Cond: "src.Email != nil", Assignments: []model.Assignment{model.SimpleField{LHS: "dst.Contact", RHS: "&pb.User_Email{Email: *src.Email}"}}},
+			},
+		}
+		expected := `switch {
+case src.Email != nil:
+dst.Contact = &pb.User_Email{Email: *src.Email}
+}
+`
+		assert.Equal(t, expected, sa.String())
+		require.False(t, sa.RetError())
+	})
+
+	t.Run("values", func(t *testing.T) {
+		sa := &model.SwitchAssignment{
+			Expr: "src",
+			Cases: []*model.SwitchCase{
+				{Cond: `"StatusActive"`, Assignments: []model.Assignment{model.SimpleField{LHS: "dst", RHS: "StatusActive"}}},
+			},
+			Default: []model.Assignment{model.RawAssignment{Raw: "err = fmt.Errorf(\"unknown Status %q\", src)\n"}},
+		}
+		expected := `switch src {
+case "StatusActive":
+dst = StatusActive
+default:
+err = fmt.Errorf("unknown Status %q", src)
+}
+`
+		assert.Equal(t, expected, sa.String())
+		require.False(t, sa.RetError())
+	})
+}
@@ -2,6 +2,8 @@ package model
 
 // Code represents the generated code.
 type Code struct {
+	// Header is the comment put at the top of the generated code.
+	Header string
 	// PackageName is the name of the package.
 	BaseCode string
 	// FunctionsBlock is the generated code for the functions.
@@ -46,10 +46,12 @@ const (
 	MatchRuleTag = MatchRule("tag")
 	// MatchRuleNone indicates that there is no matching criteria for the field.
 	MatchRuleNone = MatchRule("none")
+	// MatchRuleNormalized indicates that the field names are compared after normalizing their naming conventions.
+	MatchRuleNormalized = MatchRule("normalized")
 )
 
 // MatchRuleValues is a slice of all possible field matching rules.
-var MatchRuleValues = []MatchRule{MatchRuleName, MatchRuleTag, MatchRuleNone}
+var MatchRuleValues = []MatchRule{MatchRuleName, MatchRuleTag, MatchRuleNone, MatchRuleNormalized}
 
 // NewMatchRuleFromValue creates a new MatchRule instance from the given value string.
 func NewMatchRuleFromValue(v string) (MatchRule, bool) {
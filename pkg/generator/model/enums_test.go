@@ -47,6 +47,7 @@ func TestMatchRuleValues(t *testing.T) {
 			model.MatchRuleName,
 			model.MatchRuleTag,
 			model.MatchRuleNone,
+			model.MatchRuleNormalized,
 		}, model.MatchRuleValues)
 	})
 
@@ -63,6 +64,10 @@ func TestMatchRuleValues(t *testing.T) {
 		assert.True(t, ok)
 		assert.Equal(t, model.MatchRuleNone, rule)
 
+		rule, ok = model.NewMatchRuleFromValue("normalized")
+		assert.True(t, ok)
+		assert.Equal(t, model.MatchRuleNormalized, rule)
+
 		rule, ok = model.NewMatchRuleFromValue("invalid")
 		assert.False(t, ok)
 		assert.Equal(t, model.MatchRule(""), rule)
@@ -13,6 +13,8 @@ type Function struct {
 	Receiver       string       // Receiver is the receiver type name, if any.
 	FuncCutPrefix  string       // Receiver name prefix to cut, If there is a receiver, the name can be repeated for more consistency and neatness
 	Src            Var          // Src is the source variable.
+	Sources        []Var        // Sources is the list of the source variables, Src first. With more than one, the function returns early only if all of them are nil.
+	Params         []Var        // Params is the list of the arguments in order, including Src unless it is the receiver. If nil, Src is the sole argument.
 	Dst            Var          // Dst is the destination variable.
 	RetError       bool         // RetError indicates whether the function returns an error.
 	DstVarStyle    DstVarStyle  // DstVarStyle is the style of the destination variable declaration.
@@ -4,11 +4,13 @@ import "fmt"
 
 // Manipulator represents a function that manipulates a value.
 type Manipulator struct {
-	Pkg      string // Pkg is the package name of the function.
-	Name     string // Name is the name of the function.
-	IsDstPtr bool   // IsDstPtr indicates that the first argument is a pointer to the destination.
-	IsSrcPtr bool   // IsSrcPtr indicates that the second argument is a pointer to the source.
-	RetError bool   // RetError indicates that the function returns an error.
+	Pkg          string   // Pkg is the package name of the function.
+	Name         string   // Name is the name of the function.
+	IsDstPtr     bool     // IsDstPtr indicates that the first argument is a pointer to the destination.
+	IsSrcPtr     bool     // IsSrcPtr indicates that the second argument is a pointer to the source.
+	RetError     bool     // RetError indicates that the function returns an error.
+	LeadingArgs  []string // LeadingArgs is the list of arguments that precede the destination, such as ctx.
+	TrailingArgs []string // TrailingArgs is the list of arguments that follow the source.
 }
 
 // FuncName returns the fully qualified name of the function.
@@ -0,0 +1,68 @@
+package option
+
+import (
+	"go/token"
+	"go/types"
+)
+
+// EnumString represents a rule that converts a named integer type from and to string through its constants.
+type EnumString struct {
+	typ      string         // The enum type expression.
+	nameFunc string         // The method or function that names a value, or empty to use the constant names.
+	pos      token.Pos      // The position of the notation.
+	enumType types.Type     // The resolved enum type.
+	consts   []*types.Const // The constants of the enum type in the order of declaration.
+	method   bool           // Whether nameFunc is a method of the enum type.
+}
+
+// NewEnumString creates a new EnumString with the given parameters.
+// If nameFunc is empty, the values are named after their constants.
+func NewEnumString(typ, nameFunc string, pos token.Pos) *EnumString {
+	return &EnumString{
+		typ:      typ,
+		nameFunc: nameFunc,
+		pos:      pos,
+	}
+}
+
+// Set sets the resolved enum type, its constants and whether the name function is a method of it.
+func (e *EnumString) Set(enumType types.Type, consts []*types.Const, method bool) {
+	e.enumType = enumType
+	e.consts = consts
+	e.method = method
+}
+
+// TypeExpr returns the enum type expression.
+func (e *EnumString) TypeExpr() string {
+	return e.typ
+}
+
+// NameFunc returns the method or function that names a value, or empty.
+func (e *EnumString) NameFunc() string {
+	return e.nameFunc
+}
+
+// Pos returns the position of the notation.
+func (e *EnumString) Pos() token.Pos {
+	return e.pos
+}
+
+// EnumType returns the resolved enum type.
+func (e *EnumString) EnumType() types.Type {
+	return e.enumType
+}
+
+// Consts returns the constants of the enum type in the order of declaration.
+func (e *EnumString) Consts() []*types.Const {
+	return e.consts
+}
+
+// IsMethod returns true if the name function is a method of the enum type.
+func (e *EnumString) IsMethod() bool {
+	return e.method
+}
+
+// MatchType returns true if t is the enum type.
+func (e *EnumString) MatchType(t types.Type) bool {
+	return e.enumType != nil && types.Identical(t, e.enumType)
+}
@@ -0,0 +1,32 @@
+package option_test
+
+import (
+	"go/constant"
+	"go/token"
+	"go/types"
+	"testing"
+
+	"github.com/reedom/convergen/pkg/option"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnumString(t *testing.T) {
+	es := option.NewEnumString("domain.Status", "String", token.NoPos)
+
+	assert.Equal(t, "domain.Status", es.TypeExpr())
+	assert.Equal(t, "String", es.NameFunc())
+
+	// Unresolved rules never match.
+	assert.False(t, es.MatchType(types.Typ[types.Int]))
+
+	pkg := types.NewPackage("example.com/domain", "domain")
+	status := types.NewNamed(types.NewTypeName(token.NoPos, pkg, "Status", nil), types.Typ[types.Int], nil)
+	active := types.NewConst(token.NoPos, pkg, "StatusActive", status, constant.MakeInt64(1))
+	es.Set(status, []*types.Const{active}, true)
+
+	assert.Equal(t, status, es.EnumType())
+	assert.Equal(t, []*types.Const{active}, es.Consts())
+	assert.True(t, es.IsMethod())
+	assert.True(t, es.MatchType(status))
+	assert.False(t, es.MatchType(types.Typ[types.Int]))
+}
@@ -11,9 +11,11 @@ type FieldConverter struct {
 	m         *NameMatcher // A name matcher that matches the name of the source and destination fields.
 	converter string       // The name of the converter function.
 
-	argType  types.Type // The type of the converter's argument.
-	retType  types.Type // The type of the converter's return value.
-	retError bool       // Indicates whether the converter returns an error.
+	argType  types.Type   // The type of the converter's argument.
+	retType  types.Type   // The type of the converter's return value.
+	retError bool         // Indicates whether the converter returns an error.
+	leading  []*types.Var // The parameters that precede the argument, such as context.Context.
+	trailing []*types.Var // The parameters that follow the argument.
 }
 
 // NewFieldConverter creates a new FieldConverter with the given parameters.
@@ -31,6 +33,13 @@ func (c *FieldConverter) Set(argType, retType types.Type, returnError bool) {
 	c.retError = returnError
 }
 
+// SetParams sets the parameters other than the argument.
+// They are filled with the arguments of the generated function.
+func (c *FieldConverter) SetParams(leading, trailing []*types.Var) {
+	c.leading = leading
+	c.trailing = trailing
+}
+
 // Match returns true if the given source and destination field names match the FieldConverter's name matcher.
 func (c *FieldConverter) Match(src, dst string) bool {
 	return c.m.Match(src, dst, true)
@@ -71,6 +80,16 @@ func (c *FieldConverter) RetError() bool {
 	return c.retError
 }
 
+// LeadingParams returns the parameters that precede the converter's argument.
+func (c *FieldConverter) LeadingParams() []*types.Var {
+	return c.leading
+}
+
+// TrailingParams returns the parameters that follow the converter's argument.
+func (c *FieldConverter) TrailingParams() []*types.Var {
+	return c.trailing
+}
+
 // RHSExpr returns the right-hand side expression of the FieldConverter for a given argument.
 func (c *FieldConverter) RHSExpr(arg string) string {
 	return fmt.Sprintf("%v(%v)", c.converter, arg)
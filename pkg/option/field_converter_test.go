@@ -26,6 +26,15 @@ func TestFieldConverter(t *testing.T) {
 	assert.Equal(t, argType, fc.ArgType())
 	assert.Equal(t, retType, fc.RetType())
 	assert.True(t, fc.RetError())
+	assert.Empty(t, fc.LeadingParams())
+	assert.Empty(t, fc.TrailingParams())
+
+	// Set the types of the other parameters.
+	verbose := types.NewParam(token.NoPos, nil, "verbose", types.Typ[types.Bool])
+	limit := types.NewParam(token.NoPos, nil, "limit", types.Typ[types.Int64])
+	fc.SetParams([]*types.Var{verbose}, []*types.Var{limit})
+	assert.Equal(t, []*types.Var{verbose}, fc.LeadingParams())
+	assert.Equal(t, []*types.Var{limit}, fc.TrailingParams())
 
 	// Test the Match function.
 	assert.True(t, fc.Match("srcField", "dstField"))
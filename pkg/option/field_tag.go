@@ -0,0 +1,46 @@
+package option
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TagKey is the struct tag key for the field-level directives.
+const TagKey = "convergen"
+
+// FieldTag represents the directives in a `convergen` struct tag.
+type FieldTag struct {
+	Skip bool   // Whether the field is never copied, by "-".
+	Name string // The name of the counterpart field, by "name=<name>".
+	Conv string // The converter to apply to the field value, by "conv=<func>".
+}
+
+// ParseFieldTag parses the value of a `convergen` struct tag, such as "name=Created,conv=ToMillis".
+func ParseFieldTag(value string) (FieldTag, error) {
+	var tag FieldTag
+	if value == "-" {
+		tag.Skip = true
+		return tag, nil
+	}
+
+	for _, directive := range strings.Split(value, ",") {
+		directive = strings.TrimSpace(directive)
+		if directive == "" {
+			continue
+		}
+
+		key, arg, found := strings.Cut(directive, "=")
+		if !found || arg == "" {
+			return tag, fmt.Errorf(`invalid convergen tag directive "%v"`, directive)
+		}
+		switch key {
+		case "name":
+			tag.Name = arg
+		case "conv":
+			tag.Conv = arg
+		default:
+			return tag, fmt.Errorf(`unknown convergen tag directive "%v"`, key)
+		}
+	}
+	return tag, nil
+}
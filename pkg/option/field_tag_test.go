@@ -0,0 +1,29 @@
+package option
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFieldTag(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]FieldTag{
+		"-":                           {Skip: true},
+		"name=Created":                {Name: "Created"},
+		"conv=ToMillis":               {Conv: "ToMillis"},
+		"name=Created, conv=ToMillis": {Name: "Created", Conv: "ToMillis"},
+		"conv=model.ToMillis,":        {Conv: "model.ToMillis"},
+	}
+	for value, expected := range cases {
+		actual, err := ParseFieldTag(value)
+		assert.Nil(t, err, value)
+		assert.Equal(t, expected, actual, value)
+	}
+
+	for _, value := range []string{"name", "name=", "skip=true"} {
+		_, err := ParseFieldTag(value)
+		assert.NotNil(t, err, value)
+	}
+}
@@ -25,6 +25,11 @@ func NewIdentMatcher(pattern string) *IdentMatcher {
 	}
 }
 
+// Pattern returns the pattern string.
+func (m *IdentMatcher) Pattern() string {
+	return m.pattern
+}
+
 // Match returns true if the given ident string matches the IdentMatcher's pattern.
 // If exactCase is false, it matches case-insensitively.
 func (m *IdentMatcher) Match(ident string, exactCase bool) bool {
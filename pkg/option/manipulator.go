@@ -7,9 +7,11 @@ import (
 
 // Manipulator represents a manipulator that manipulates the source and destination types.
 type Manipulator struct {
-	Func     types.Object // Func represents the function object that this manipulator invokes.
-	DstSide  types.Type   // DstSide is the type expression of the destination side.
-	SrcSide  types.Type   // SrcSide is the type expression of the source side.
-	RetError bool         // RetError indicates whether the manipulator returns an error or not.
-	Pos      token.Pos    // Pos represents the position of the manipulator in the source code.
+	Func           types.Object // Func represents the function object that this manipulator invokes.
+	DstSide        types.Type   // DstSide is the type expression of the destination side.
+	SrcSide        types.Type   // SrcSide is the type expression of the source side.
+	LeadingParams  []*types.Var // LeadingParams are the parameters before the destination side, such as context.Context.
+	TrailingParams []*types.Var // TrailingParams are the parameters after the source side.
+	RetError       bool         // RetError indicates whether the manipulator returns an error or not.
+	Pos            token.Pos    // Pos represents the position of the manipulator in the source code.
 }
@@ -0,0 +1,90 @@
+package option
+
+import (
+	"strings"
+	"unicode"
+)
+
+// NormalizeFieldName returns the canonical key of a field name for the normalized matching rule.
+// The name is split into words at underscores, hyphens and case boundaries, so that initialisms
+// like "URLPath" split into "URL" and "Path". A leading word sequence in prefixes and a trailing one
+// in suffixes are removed once each, unless nothing would be left.
+// The remaining words are lower-cased and joined, e.g. "UserID", "UserId" and "user_id" all become "userid".
+func NormalizeFieldName(name string, prefixes, suffixes []string) string {
+	words := lowerWords(name)
+
+	for _, prefix := range prefixes {
+		affix := lowerWords(prefix)
+		if 0 < len(affix) && len(affix) < len(words) && equalWords(words[:len(affix)], affix) {
+			words = words[len(affix):]
+			break
+		}
+	}
+	for _, suffix := range suffixes {
+		affix := lowerWords(suffix)
+		if 0 < len(affix) && len(affix) < len(words) && equalWords(words[len(words)-len(affix):], affix) {
+			words = words[:len(words)-len(affix)]
+			break
+		}
+	}
+	return strings.Join(words, "")
+}
+
+// lowerWords splits name into lower-cased words.
+func lowerWords(name string) []string {
+	words := splitWords(name)
+	for i, w := range words {
+		words[i] = strings.ToLower(w)
+	}
+	return words
+}
+
+// splitWords splits an identifier into words at separators and case boundaries.
+// A run of upper case letters is an initialism; its last letter starts the next word if a lower case letter follows,
+// except for a plural "s" like in "IDs".
+func splitWords(name string) []string {
+	var words []string
+	runes := []rune(name)
+	start := 0
+	for i, r := range runes {
+		switch {
+		case r == '_' || r == '-':
+			if start < i {
+				words = append(words, string(runes[start:i]))
+			}
+			start = i + 1
+		case start < i && unicode.IsUpper(r):
+			prev := runes[i-1]
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) ||
+				(unicode.IsUpper(prev) && i+1 < len(runes) && unicode.IsLower(runes[i+1]) && !isPluralS(runes, i+1)) {
+				words = append(words, string(runes[start:i]))
+				start = i
+			}
+		}
+	}
+	if start < len(runes) {
+		words = append(words, string(runes[start:]))
+	}
+	return words
+}
+
+// isPluralS reports whether runes[i] is a lone "s" that ends a word.
+func isPluralS(runes []rune, i int) bool {
+	if runes[i] != 's' {
+		return false
+	}
+	return i+1 == len(runes) || !unicode.IsLower(runes[i+1])
+}
+
+// equalWords reports whether a and b consist of the same words.
+func equalWords(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
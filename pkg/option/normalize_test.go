@@ -0,0 +1,43 @@
+package option
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeFieldName(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		prefixes []string
+		suffixes []string
+		expected string
+	}{
+		{name: "UserID", expected: "userid"},
+		{name: "UserId", expected: "userid"},
+		{name: "user_id", expected: "userid"},
+		{name: "userId", expected: "userid"},
+		{name: "URLPath", expected: "urlpath"},
+		{name: "Url", expected: "url"},
+		{name: "m_url", prefixes: []string{"m_"}, expected: "url"},
+		{name: "mURL", prefixes: []string{"m"}, expected: "url"},
+		{name: "Mode", prefixes: []string{"m"}, expected: "mode"},
+		{name: "NameField", suffixes: []string{"Field"}, expected: "name"},
+		{name: "Field", suffixes: []string{"Field"}, expected: "field"},
+	}
+
+	for _, tt := range cases {
+		assert.Equal(t, tt.expected, NormalizeFieldName(tt.name, tt.prefixes, tt.suffixes), tt.name)
+	}
+}
+
+func TestSplitWords(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{"URL", "Path"}, splitWords("URLPath"))
+	assert.Equal(t, []string{"user", "IDs"}, splitWords("userIDs"))
+	assert.Equal(t, []string{"Address2", "Line"}, splitWords("Address2Line"))
+	assert.Equal(t, []string{"user", "id"}, splitWords("__user__id"))
+}
@@ -1,6 +1,9 @@
 package option
 
 import (
+	"go/token"
+	"go/types"
+	"slices"
 	"strings"
 
 	"github.com/reedom/convergen/pkg/generator/model"
@@ -10,16 +13,36 @@ import (
 type Options struct {
 	Style               model.DstVarStyle // Style of the destination variable name
 	Rule                model.MatchRule   // Matching rule for fields
+	MatchTag            string            // Struct tag key to pair fields with in the tag matching rule
+	MatchTagFallback    bool              // Whether to fall back to name matching if either field lacks the tag
+	TrimPrefixes        []string          // Name prefixes to ignore in the normalized matching rule
+	TrimSuffixes        []string          // Name suffixes to ignore in the normalized matching rule
 	ExactCase           bool              // Whether to match fields with exact case sensitivity
 	Getter              bool              // Whether to use getter methods to access fields
 	Stringer            bool              // Whether to use stringer methods to convert values to strings
 	Typecast            bool              // Whether to use explicit typecasts when converting values
+	Ptr                 bool              // Whether to bridge pointer and non-pointer types with nil checks
+	SkipNil             bool              // Whether to drop nil elements of a slice rather than keeping zero values in their places
+	SQLNull             bool              // Whether to convert the Null types of database/sql from and to their values
+	Time                *TimeFormat       // Format to convert time.Time and time.Duration from and to int64 or string, or nil
+	Proto               bool              // Whether to treat structs with protobuf struct tags as protoc-gen-go messages
+	Enum                bool              // Whether to map enum types to each other by the names of their constants
+	EnumPrefixes        []string          // Constant name prefixes to ignore in pairing the constants of enum types
+	Strict              bool              // Whether to fail generation on unmatched destination fields
 	Receiver            string            // Receiver name for method generation
 	FuncCutPrefix       string            // Receiver name prefix to cut, If there is a receiver, the name can be repeated for more consistency and neatness
 	Reverse             bool              // Whether to reverse the order of struct tags
+	Sources             []string          // Names of the source variables, or empty to take the leading struct arguments
+	Precedence          []string          // Names of the source variables in the order of precedence for field matching
 	SkipFields          []*PatternMatcher // List of field names to skip during conversion
+	ExhaustiveSrc       bool              // Whether to report source fields that no assignment consumes
+	IgnoreSrcFields     []*PatternMatcher // List of source field names to leave out of the exhaustiveness check
 	NameMapper          []*NameMatcher    // List of field name mapping rules
+	RenameRules         []*RenameRule     // List of pattern-based field name mapping rules
 	Converters          []*FieldConverter // List of field conversion rules
+	TypeConverters      []*TypeConverter  // List of type conversion rules
+	EnumStrings         []*EnumString     // List of enum to and from string conversion rules
+	StructConverters    []*FieldConverter // List of struct-to-field conversion rules
 	Literals            []*LiteralSetter  // List of literal value setting rules
 	Methods             []*FieldConverter // List of method value setting rules
 	PreProcess          *Manipulator      // Manipulator to run before struct processing
@@ -29,8 +52,13 @@ type Options struct {
 	BuildMaskIgnores    []*MaskConverter
 	MaskExtension       *MaskExtension
 	Mask                *Mask
+	InheritedRules      map[token.Pos]struct{}         // Positions of the mapping rules inherited from the interface
+	TagConverters       map[*types.Var]*FieldConverter // Converters in the `convergen` struct tags, keyed by field
 }
 
+// DefaultMatchTag is the struct tag key used by the tag matching rule when none is specified.
+const DefaultMatchTag = "json"
+
 // NewOptions returns a new Options instance.
 func NewOptions() Options {
 	return Options{
@@ -40,6 +68,7 @@ func NewOptions() Options {
 		Getter:    false,
 		Stringer:  false,
 		Typecast:  false,
+		Ptr:       true,
 	}
 }
 
@@ -53,57 +82,214 @@ func (o Options) ShouldSkip(fieldName string) bool {
 	return false
 }
 
+// ShouldIgnoreSrc returns true if the source field with the given name is left out of the exhaustiveness check.
+func (o Options) ShouldIgnoreSrc(fieldName string) bool {
+	for _, ignore := range o.IgnoreSrcFields {
+		if ignore.Match(fieldName, o.ExactCase) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsInherited returns true if the mapping rule at pos is inherited from the interface.
+func (o Options) IsInherited(pos token.Pos) bool {
+	_, ok := o.InheritedRules[pos]
+	return ok
+}
+
+// AddressesUnder returns true if any field-specific rule addresses a member under the destination path dst
+// or the source path src, as "Category.Name" does under "Category". A regexp in :skip may address any member.
+func (o Options) AddressesUnder(dst, src string) bool {
+	under := func(m *IdentMatcher, path string) bool {
+		if m == nil {
+			return false
+		}
+		prefix := path + "."
+		if o.ExactCase {
+			return strings.HasPrefix(m.Pattern(), prefix)
+		}
+		return strings.HasPrefix(strings.ToLower(m.Pattern()), strings.ToLower(prefix))
+	}
+
+	for _, skip := range o.SkipFields {
+		if skip.IsRegexp() || under(NewIdentMatcher(skip.pattern), dst) {
+			return true
+		}
+	}
+	for _, m := range o.NameMapper {
+		if under(m.Dst(), dst) || under(m.Src(), src) {
+			return true
+		}
+	}
+	for _, list := range [][]*FieldConverter{o.Converters, o.StructConverters, o.Methods} {
+		for _, c := range list {
+			if under(c.Dst(), dst) || under(c.Src(), src) {
+				return true
+			}
+		}
+	}
+	for _, l := range o.Literals {
+		if under(l.Dst(), dst) {
+			return true
+		}
+	}
+	for _, list := range [][]*MaskConverter{o.ParseMaskConverters, o.BuildMaskConverters, o.BuildMaskIgnores} {
+		for _, c := range list {
+			if under(c.Dst(), dst) || under(c.Src(), src) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Clone returns a copy of the options whose lists can be appended to without affecting o,
+// so that the interfaces and the methods inheriting the same options don't share the additions.
+func (o Options) Clone() Options {
+	c := o
+	c.TrimPrefixes, c.TrimSuffixes = slices.Clip(o.TrimPrefixes), slices.Clip(o.TrimSuffixes)
+	c.SkipFields, c.IgnoreSrcFields = slices.Clip(o.SkipFields), slices.Clip(o.IgnoreSrcFields)
+	c.NameMapper, c.RenameRules = slices.Clip(o.NameMapper), slices.Clip(o.RenameRules)
+	c.Converters, c.TypeConverters = slices.Clip(o.Converters), slices.Clip(o.TypeConverters)
+	c.EnumStrings, c.StructConverters = slices.Clip(o.EnumStrings), slices.Clip(o.StructConverters)
+	c.Literals, c.Methods = slices.Clip(o.Literals), slices.Clip(o.Methods)
+	c.ParseMaskConverters, c.BuildMaskConverters = slices.Clip(o.ParseMaskConverters), slices.Clip(o.BuildMaskConverters)
+	return c
+}
+
+// ForHelper returns the options to build a helper function that copies a nested struct with.
+// It drops the rules addressing fields by their paths from the method's variables, and the options
+// of the method's signature, keeping those that apply to any field such as :typecast and :conv:type.
+func (o Options) ForHelper() Options {
+	h := o
+	h.Style = model.DstVarReturn
+	h.Receiver, h.FuncCutPrefix = "", ""
+	h.Reverse = false
+	h.Sources, h.Precedence = nil, nil
+	h.SkipFields = nil
+	h.ExhaustiveSrc, h.IgnoreSrcFields = false, nil
+	h.NameMapper = nil
+	h.Converters, h.StructConverters, h.Methods = nil, nil, nil
+	h.Literals = nil
+	h.PreProcess, h.PostProcess = nil, nil
+	h.ParseMaskConverters, h.BuildMaskConverters, h.BuildMaskIgnores = nil, nil, nil
+	h.MaskExtension, h.Mask = nil, nil
+	h.InheritedRules = nil
+	return h
+}
+
 // CompareFieldName compares two field names.
+// With the normalized matching rule, the names are compared by their canonical keys.
 func (o Options) CompareFieldName(a, b string) bool {
+	if o.Rule == model.MatchRuleNormalized {
+		return NormalizeFieldName(a, o.TrimPrefixes, o.TrimSuffixes) == NormalizeFieldName(b, o.TrimPrefixes, o.TrimSuffixes)
+	}
 	if o.ExactCase {
 		return a == b
 	}
 	return strings.EqualFold(a, b)
 }
 
+// TagKey returns the struct tag key used by the tag matching rule.
+func (o Options) TagKey() string {
+	if o.MatchTag == "" {
+		return DefaultMatchTag
+	}
+	return o.MatchTag
+}
+
 // ValidOpsIntf is a set of valid conversion option keys for interface-level conversion.
 var ValidOpsIntf = map[string]struct{}{
-	"convergen":    {},
-	"style":        {},
-	"match":        {},
-	"case":         {},
-	"case:off":     {},
-	"getter":       {},
-	"getter:off":   {},
-	"stringer":     {},
-	"stringer:off": {},
-	"typecast":     {},
-	"typecast:off": {},
-	"skip":         {},
+	"convergen":          {},
+	"style":              {},
+	"match":              {},
+	"case":               {},
+	"case:off":           {},
+	"getter":             {},
+	"getter:off":         {},
+	"stringer":           {},
+	"stringer:off":       {},
+	"typecast":           {},
+	"typecast:off":       {},
+	"ptr":                {},
+	"ptr:off":            {},
+	"nil:skip":           {},
+	"nil:keep":           {},
+	"sqlnull":            {},
+	"sqlnull:off":        {},
+	"time":               {},
+	"time:off":           {},
+	"proto":              {},
+	"proto:off":          {},
+	"enum":               {},
+	"enum:off":           {},
+	"strict":             {},
+	"strict:off":         {},
+	"exhaustive:src":     {},
+	"exhaustive:src:off": {},
+	"ignore:src":         {},
+	"skip":               {},
+	"map":                {},
+	"map:prefix":         {},
+	"conv":               {},
+	"conv:type":          {},
+	"enum:string":        {},
+	"method":             {},
+	"method:err":         {},
+	"literal":            {},
+	"use":                {},
 }
 
 // ValidOpsMethod is a set of valid conversion option keys for method-level conversion.
 var ValidOpsMethod = map[string]struct{}{
-	"style":        {},
-	"match":        {},
-	"case":         {},
-	"case:off":     {},
-	"getter":       {},
-	"getter:off":   {},
-	"stringer":     {},
-	"stringer:off": {},
-	"typecast":     {},
-	"typecast:off": {},
-	"recv":         {},
-	"reverse":      {},
-	"skip":         {},
-	"map":          {},
-	"tag":          {},
-	"conv":         {},
-	"conv:type":    {},
-	"conv:with":    {},
-	"method":       {},
-	"method:err":   {},
-	"literal":      {},
-	"preprocess":   {},
-	"postprocess":  {},
-	"parsemask":    {},
-	"buildmask":    {},
-	"mask:ext":     {},
-	"mask":         {},
+	"style":              {},
+	"match":              {},
+	"case":               {},
+	"case:off":           {},
+	"getter":             {},
+	"getter:off":         {},
+	"stringer":           {},
+	"stringer:off":       {},
+	"typecast":           {},
+	"typecast:off":       {},
+	"ptr":                {},
+	"ptr:off":            {},
+	"nil:skip":           {},
+	"nil:keep":           {},
+	"sqlnull":            {},
+	"sqlnull:off":        {},
+	"time":               {},
+	"time:off":           {},
+	"proto":              {},
+	"proto:off":          {},
+	"enum":               {},
+	"enum:off":           {},
+	"strict":             {},
+	"strict:off":         {},
+	"exhaustive:src":     {},
+	"exhaustive:src:off": {},
+	"ignore:src":         {},
+	"recv":               {},
+	"reverse":            {},
+	"src":                {},
+	"precedence":         {},
+	"skip":               {},
+	"map":                {},
+	"map:prefix":         {},
+	"tag":                {},
+	"conv":               {},
+	"conv:type":          {},
+	"enum:string":        {},
+	"conv:with":          {},
+	"method":             {},
+	"method:err":         {},
+	"literal":            {},
+	"preprocess":         {},
+	"postprocess":        {},
+	"parsemask":          {},
+	"buildmask":          {},
+	"mask:ext":           {},
+	"mask":               {},
+	"use":                {},
 }
@@ -0,0 +1,81 @@
+package option
+
+import (
+	"testing"
+
+	"github.com/reedom/convergen/pkg/generator/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptions_AddressesUnder(t *testing.T) {
+	t.Parallel()
+
+	skip := func(pattern string) *PatternMatcher {
+		m, err := NewPatternMatcher(pattern, true)
+		require.Nil(t, err)
+		return m
+	}
+
+	cases := []struct {
+		name     string
+		opts     Options
+		expected bool
+	}{
+		{name: "none", opts: NewOptions(), expected: false},
+		{name: "skip", opts: Options{ExactCase: true, SkipFields: []*PatternMatcher{skip("Category.Name")}}, expected: true},
+		{name: "skip other", opts: Options{ExactCase: true, SkipFields: []*PatternMatcher{skip("CategoryName")}}, expected: false},
+		{name: "skip regexp", opts: Options{ExactCase: true, SkipFields: []*PatternMatcher{skip("/ID$/")}}, expected: true},
+		{name: "map dst", opts: Options{NameMapper: []*NameMatcher{NewNameMatcher("ID", "Category.ID", 0)}}, expected: true},
+		{name: "map src", opts: Options{NameMapper: []*NameMatcher{NewNameMatcher("Group().ID", "ID", 0)}}, expected: true},
+		{name: "map at", opts: Options{NameMapper: []*NameMatcher{NewNameMatcher("Group()", "Category", 0)}}, expected: false},
+		{name: "conv", opts: Options{Converters: []*FieldConverter{NewFieldConverter("f", "Name", "category.name", 0)}}, expected: true},
+		{name: "conv exact case", opts: Options{ExactCase: true, Converters: []*FieldConverter{NewFieldConverter("f", "Name", "category.name", 0)}}, expected: false},
+		{name: "literal", opts: Options{Literals: []*LiteralSetter{NewLiteralSetter("Category.Name", `""`, 0)}}, expected: true},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, tt.opts.AddressesUnder("Category", "Group()"))
+		})
+	}
+}
+
+func TestOptions_ForHelper(t *testing.T) {
+	t.Parallel()
+
+	opts := NewOptions()
+	opts.Style = model.DstVarArg
+	opts.Receiver = "src"
+	opts.Typecast = true
+	opts.NameMapper = []*NameMatcher{NewNameMatcher("ID", "Category.ID", 0)}
+	opts.TypeConverters = []*TypeConverter{NewTypeConverter("MillisToTime", "int64", "", 0)}
+
+	h := opts.ForHelper()
+	assert.Equal(t, model.DstVarReturn, h.Style)
+	assert.Equal(t, "", h.Receiver)
+	assert.True(t, h.Typecast)
+	assert.Nil(t, h.NameMapper)
+	assert.Len(t, h.TypeConverters, 1)
+}
+
+func TestOptions_Clone(t *testing.T) {
+	t.Parallel()
+
+	opts := NewOptions()
+	opts.NameMapper = make([]*NameMatcher, 1, 4)
+	opts.NameMapper[0] = NewNameMatcher("ID", "UserID", 0)
+
+	name, mail := NewNameMatcher("Name", "FullName", 0), NewNameMatcher("Mail", "Email", 0)
+	a, b := opts.Clone(), opts.Clone()
+	a.NameMapper = append(a.NameMapper, name)
+	b.NameMapper = append(b.NameMapper, mail)
+
+	require.Len(t, a.NameMapper, 2)
+	require.Len(t, b.NameMapper, 2)
+	assert.Same(t, name, a.NameMapper[1])
+	assert.Same(t, mail, b.NameMapper[1])
+	assert.Len(t, opts.NameMapper, 1)
+}
@@ -40,11 +40,21 @@ func (m *PatternMatcher) Match(ident string, exactCase bool) bool {
 	return m.re.MatchString(s)
 }
 
+// IsRegexp returns true if the pattern is a regular expression in /…/ syntax.
+func (m *PatternMatcher) IsRegexp() bool {
+	return isRegexp(m.pattern)
+}
+
+// isRegexp returns true if the pattern is in /…/ syntax.
+func isRegexp(pattern string) bool {
+	return strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/") && 2 <= len(pattern)
+}
+
 // compileRegexp compiles the given pattern into a regular expression.
 // If exactCase is false, the pattern is case-insensitive.
 func compileRegexp(pattern string, exactCase bool) (*regexp.Regexp, error) {
 	var expr string
-	if strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/") && 2 <= len(pattern) {
+	if isRegexp(pattern) {
 		expr = pattern[1 : len(pattern)-1]
 	} else {
 		expr = fmt.Sprintf("^%v$", regexp.QuoteMeta(pattern))
@@ -0,0 +1,50 @@
+package option
+
+import (
+	"go/token"
+	"regexp"
+	"strings"
+)
+
+// RenameRule renames source field names by a regular expression and a template,
+// so that they match the destination field names.
+type RenameRule struct {
+	re       *regexp.Regexp // The regular expression for the source field names.
+	template string         // The template for the destination field names, such as "$1".
+	pos      token.Pos      // The position of the rule in the file.
+}
+
+// IsRenamePattern reports whether the :map source argument is a regular expression in /…/ syntax.
+func IsRenamePattern(src string) bool {
+	return 2 <= len(src) && strings.HasPrefix(src, "/") && strings.HasSuffix(src, "/")
+}
+
+// NewRenameRule creates a new RenameRule from a regular expression in /…/ syntax and a template.
+func NewRenameRule(pattern, template string, pos token.Pos) (*RenameRule, error) {
+	re, err := compileRegexp(pattern, true)
+	if err != nil {
+		return nil, err
+	}
+	return &RenameRule{re: re, template: template, pos: pos}, nil
+}
+
+// NewPrefixRenameRule creates a new RenameRule that replaces the srcPrefix of source field names with dstPrefix.
+func NewPrefixRenameRule(srcPrefix, dstPrefix string, pos token.Pos) *RenameRule {
+	re := regexp.MustCompile("^" + regexp.QuoteMeta(srcPrefix) + "(.+)$")
+	return &RenameRule{re: re, template: dstPrefix + "${1}", pos: pos}
+}
+
+// Rename returns the destination field name for the source field name.
+// It returns false if the name doesn't match the rule.
+func (r *RenameRule) Rename(name string) (string, bool) {
+	match := r.re.FindStringSubmatchIndex(name)
+	if match == nil {
+		return "", false
+	}
+	return string(r.re.ExpandString(nil, r.template, name, match)), true
+}
+
+// Pos returns the token.Pos of RenameRule.
+func (r *RenameRule) Pos() token.Pos {
+	return r.pos
+}
@@ -0,0 +1,42 @@
+package option
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenameRule(t *testing.T) {
+	t.Parallel()
+
+	rule, err := NewRenameRule("/^Src(.*)$/", "$1", 0)
+	require.Nil(t, err)
+
+	renamed, ok := rule.Rename("SrcName")
+	assert.True(t, ok)
+	assert.Equal(t, "Name", renamed)
+
+	_, ok = rule.Rename("Name")
+	assert.False(t, ok)
+
+	_, err = NewRenameRule("/(/", "$1", 0)
+	assert.NotNil(t, err)
+}
+
+func TestPrefixRenameRule(t *testing.T) {
+	t.Parallel()
+
+	rule := NewPrefixRenameRule("Biz", "", 0)
+	renamed, ok := rule.Rename("BizName")
+	assert.True(t, ok)
+	assert.Equal(t, "Name", renamed)
+
+	_, ok = rule.Rename("Biz")
+	assert.False(t, ok)
+
+	rule = NewPrefixRenameRule("Biz", "Db", 0)
+	renamed, ok = rule.Rename("BizID")
+	assert.True(t, ok)
+	assert.Equal(t, "DbID", renamed)
+}
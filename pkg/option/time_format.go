@@ -0,0 +1,39 @@
+package option
+
+import (
+	"strings"
+	"time"
+)
+
+const timeLayoutPrefix = "layout:"
+
+// TimeFormat represents the format that time.Time and time.Duration values are converted from and to.
+type TimeFormat struct {
+	unit   string // "unix" or "unixmilli" for the int64 form, or empty.
+	layout string // The layout for the string form of time.Time, or empty.
+}
+
+// NewTimeFormat creates a new TimeFormat from the argument of the :time notation,
+// which is one of "unix", "unixmilli", "rfc3339" and "layout:<layout>".
+func NewTimeFormat(arg string) (*TimeFormat, bool) {
+	switch arg {
+	case "unix", "unixmilli":
+		return &TimeFormat{unit: arg}, true
+	case "rfc3339":
+		return &TimeFormat{layout: time.RFC3339}, true
+	}
+	if strings.HasPrefix(arg, timeLayoutPrefix) && len(timeLayoutPrefix) < len(arg) {
+		return &TimeFormat{layout: strings.TrimPrefix(arg, timeLayoutPrefix)}, true
+	}
+	return nil, false
+}
+
+// Unit returns "unix" or "unixmilli" if the values are converted from and to int64, or empty.
+func (f *TimeFormat) Unit() string {
+	return f.unit
+}
+
+// Layout returns the layout for time.Time.Format and time.Parse, or empty.
+func (f *TimeFormat) Layout() string {
+	return f.layout
+}
@@ -0,0 +1,35 @@
+package option_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reedom/convergen/pkg/option"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTimeFormat(t *testing.T) {
+	testCases := []struct {
+		arg    string
+		ok     bool
+		unit   string
+		layout string
+	}{
+		{"unix", true, "unix", ""},
+		{"unixmilli", true, "unixmilli", ""},
+		{"rfc3339", true, "", time.RFC3339},
+		{"layout:2006-01-02 15:04", true, "", "2006-01-02 15:04"},
+		{"layout:", false, "", ""},
+		{"unixnano", false, "", ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.arg, func(t *testing.T) {
+			f, ok := option.NewTimeFormat(tc.arg)
+			if !assert.Equal(t, tc.ok, ok) || !ok {
+				return
+			}
+			assert.Equal(t, tc.unit, f.Unit())
+			assert.Equal(t, tc.layout, f.Layout())
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package option
+
+import (
+	"go/token"
+	"go/types"
+)
+
+// TypeConverter represents a converter that applies to every field whose source and destination types match.
+type TypeConverter struct {
+	*FieldConverter // The converter function and its signature.
+
+	src     string     // The source type expression.
+	dst     string     // The destination type expression. Can be empty.
+	srcType types.Type // The resolved source type.
+	dstType types.Type // The resolved destination type.
+}
+
+// NewTypeConverter creates a new TypeConverter with the given parameters.
+// If dst is empty, the return type of the converter becomes the destination type.
+func NewTypeConverter(converter, src, dst string, pos token.Pos) *TypeConverter {
+	return &TypeConverter{
+		FieldConverter: NewFieldConverter(converter, "", "", pos),
+		src:            src,
+		dst:            dst,
+	}
+}
+
+// SetTypes sets the resolved source and destination types.
+func (c *TypeConverter) SetTypes(srcType, dstType types.Type) {
+	c.srcType = srcType
+	c.dstType = dstType
+}
+
+// SrcExpr returns the source type expression.
+func (c *TypeConverter) SrcExpr() string {
+	return c.src
+}
+
+// DstExpr returns the destination type expression.
+func (c *TypeConverter) DstExpr() string {
+	return c.dst
+}
+
+// SrcType returns the resolved source type.
+func (c *TypeConverter) SrcType() types.Type {
+	return c.srcType
+}
+
+// DstType returns the resolved destination type.
+func (c *TypeConverter) DstType() types.Type {
+	return c.dstType
+}
+
+// MatchTypes returns true if the converter converts a value of srcType into a value that is assignable to dstType.
+func (c *TypeConverter) MatchTypes(srcType, dstType types.Type) bool {
+	if c.srcType == nil || c.dstType == nil {
+		return false
+	}
+	return types.Identical(srcType, c.srcType) && types.AssignableTo(c.dstType, dstType)
+}
@@ -0,0 +1,30 @@
+package option_test
+
+import (
+	"go/token"
+	"go/types"
+	"testing"
+
+	"github.com/reedom/convergen/pkg/option"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTypeConverter(t *testing.T) {
+	tc := option.NewTypeConverter("toMillis", "time.Time", "", token.NoPos)
+
+	assert.Equal(t, "toMillis", tc.Converter())
+	assert.Equal(t, "time.Time", tc.SrcExpr())
+	assert.Equal(t, "", tc.DstExpr())
+
+	// Unresolved converters never match.
+	assert.False(t, tc.MatchTypes(types.Typ[types.Int], types.Typ[types.Int64]))
+
+	tc.Set(types.Typ[types.Int], types.Typ[types.Int64], false)
+	tc.SetTypes(types.Typ[types.Int], types.Typ[types.Int64])
+	assert.Equal(t, types.Typ[types.Int], tc.SrcType())
+	assert.Equal(t, types.Typ[types.Int64], tc.DstType())
+
+	assert.True(t, tc.MatchTypes(types.Typ[types.Int], types.Typ[types.Int64]))
+	assert.False(t, tc.MatchTypes(types.Typ[types.Int32], types.Typ[types.Int64]))
+	assert.False(t, tc.MatchTypes(types.Typ[types.Int], types.Typ[types.String]))
+}
@@ -32,6 +32,11 @@ var (
 func (p *Parser) parseNotationInComments(notations []*ast.Comment, validOps map[string]struct{}, opts *option.Options) error {
 	var posReverse token.Pos
 
+	notations, err := p.expandPresets(notations, validOps, nil)
+	if err != nil {
+		return err
+	}
+
 	for _, n := range notations {
 		m := reNotation.FindStringSubmatch(n.Text)
 		if len(m) < 2 {
@@ -49,7 +54,7 @@ func (p *Parser) parseNotationInComments(notations []*ast.Comment, validOps map[
 		}
 
 		switch m[1] {
-		case "convergen":
+		case "convergen", "use":
 			// do nothing
 		case "style":
 			if len(args) == 0 {
@@ -66,6 +71,33 @@ func (p *Parser) parseNotationInComments(notations []*ast.Comment, validOps map[
 				return logger.Errorf("%v: invalid <algorithm> arg", p.fset.Position(n.Pos()))
 			} else {
 				opts.Rule = rule
+				if rule == gmodel.MatchRuleTag {
+					// :match tag [<key> [name]]
+					if 1 < len(args) {
+						opts.MatchTag = args[1]
+					}
+					if 2 < len(args) {
+						if args[2] != gmodel.MatchRuleName.String() {
+							return logger.Errorf("%v: invalid <fallback> arg", p.fset.Position(n.Pos()))
+						}
+						opts.MatchTagFallback = true
+					}
+				}
+				if rule == gmodel.MatchRuleNormalized {
+					// :match normalized [prefix=<prefix>|suffix=<suffix>]...
+					opts.TrimPrefixes, opts.TrimSuffixes = nil, nil
+					for _, arg := range args[1:] {
+						key, value, ok := strings.Cut(arg, "=")
+						switch {
+						case ok && key == "prefix" && value != "":
+							opts.TrimPrefixes = append(opts.TrimPrefixes, value)
+						case ok && key == "suffix" && value != "":
+							opts.TrimSuffixes = append(opts.TrimSuffixes, value)
+						default:
+							return logger.Errorf("%v: invalid affix arg %v", p.fset.Position(n.Pos()), arg)
+						}
+					}
+				}
 			}
 		case "case":
 			opts.ExactCase = true
@@ -83,6 +115,60 @@ func (p *Parser) parseNotationInComments(notations []*ast.Comment, validOps map[
 			opts.Typecast = true
 		case "typecast:off":
 			opts.Typecast = false
+		case "ptr":
+			opts.Ptr = true
+		case "ptr:off":
+			opts.Ptr = false
+		case "nil:skip":
+			opts.SkipNil = true
+		case "nil:keep":
+			opts.SkipNil = false
+		case "sqlnull":
+			opts.SQLNull = true
+		case "sqlnull:off":
+			opts.SQLNull = false
+		case "time":
+			if len(args) == 0 {
+				return logger.Errorf("%v: needs <format> arg", p.fset.Position(n.Pos()))
+			}
+			// A layout may contain spaces.
+			format, ok := option.NewTimeFormat(strings.TrimSpace(m[2]))
+			if !ok {
+				return logger.Errorf("%v: invalid <format> arg", p.fset.Position(n.Pos()))
+			}
+			opts.Time = format
+		case "time:off":
+			opts.Time = nil
+		case "proto":
+			opts.Proto = true
+		case "proto:off":
+			opts.Proto = false
+		case "enum":
+			// :enum [prefix]...
+			opts.Enum = true
+			opts.EnumPrefixes = args
+		case "enum:off":
+			opts.Enum = false
+			opts.EnumPrefixes = nil
+		case "strict":
+			opts.Strict = true
+		case "strict:off":
+			opts.Strict = false
+		case "exhaustive:src":
+			opts.ExhaustiveSrc = true
+		case "exhaustive:src:off":
+			opts.ExhaustiveSrc = false
+		case "ignore:src":
+			if len(args) == 0 {
+				return logger.Errorf("%v: needs <field> <field2> ...", p.fset.Position(n.Pos()))
+			}
+			for _, arg := range args {
+				matcher, err := option.NewPatternMatcher(arg, opts.ExactCase)
+				if err != nil {
+					return logger.Errorf("%v: invalid regexp", p.fset.Position(n.Pos()))
+				}
+				opts.IgnoreSrcFields = append(opts.IgnoreSrcFields, matcher)
+			}
 		case "recv":
 			if len(args) == 0 {
 				return logger.Errorf("%v: needs name for the receiver", p.fset.Position(n.Pos()))
@@ -101,6 +187,26 @@ func (p *Parser) parseNotationInComments(notations []*ast.Comment, validOps map[
 		case "reverse":
 			opts.Reverse = true
 			posReverse = n.Pos()
+		case "src":
+			if len(args) == 0 {
+				return logger.Errorf("%v: needs <src> <src2> ...", p.fset.Position(n.Pos()))
+			}
+			for _, arg := range args {
+				if !isValidIdentifier(arg) {
+					return logger.Errorf("%v: invalid ident", p.fset.Position(n.Pos()))
+				}
+			}
+			opts.Sources = args
+		case "precedence":
+			if len(args) == 0 {
+				return logger.Errorf("%v: needs <src> <src2> ...", p.fset.Position(n.Pos()))
+			}
+			for _, arg := range args {
+				if !isValidIdentifier(arg) {
+					return logger.Errorf("%v: invalid ident", p.fset.Position(n.Pos()))
+				}
+			}
+			opts.Precedence = args
 		case "skip":
 			if len(args) == 0 {
 				return logger.Errorf("%v: needs <field> <field2> ...", p.fset.Position(n.Pos()))
@@ -118,8 +224,26 @@ func (p *Parser) parseNotationInComments(notations []*ast.Comment, validOps map[
 			if len(args) < 2 {
 				return logger.Errorf("%v: needs <src> <dst>", p.fset.Position(n.Pos()))
 			}
+			if option.IsRenamePattern(args[0]) {
+				rule, err := option.NewRenameRule(args[0], args[1], n.Pos())
+				if err != nil {
+					return logger.Errorf("%v: invalid regexp", p.fset.Position(n.Pos()))
+				}
+				opts.RenameRules = append(opts.RenameRules, rule)
+				break
+			}
 			matcher := option.NewNameMatcher(args[0], args[1], n.Pos())
 			opts.NameMapper = append(opts.NameMapper, matcher)
+		case "map:prefix":
+			if len(args) < 1 {
+				return logger.Errorf("%v: needs <src prefix> [dst prefix]", p.fset.Position(n.Pos()))
+			}
+			dstPrefix := ""
+			if 2 <= len(args) {
+				dstPrefix = args[1]
+			}
+			rule := option.NewPrefixRenameRule(args[0], dstPrefix, n.Pos())
+			opts.RenameRules = append(opts.RenameRules, rule)
 		case "conv":
 			if len(args) < 2 {
 				return logger.Errorf("%v: needs <conv> <src> <dst>", p.fset.Position(n.Pos()))
@@ -131,6 +255,33 @@ func (p *Parser) parseNotationInComments(notations []*ast.Comment, validOps map[
 			}
 			converter := option.NewFieldConverter(args[0], src, dst, n.Pos())
 			opts.Converters = append(opts.Converters, converter)
+		case "conv:type":
+			if len(args) < 2 {
+				return logger.Errorf("%v: needs <conv> <src type> [dst type]", p.fset.Position(n.Pos()))
+			}
+			dst := ""
+			if 3 <= len(args) {
+				dst = args[2]
+			}
+			converter := option.NewTypeConverter(args[0], args[1], dst, n.Pos())
+			opts.TypeConverters = append(opts.TypeConverters, converter)
+		case "enum:string":
+			if len(args) < 1 {
+				return logger.Errorf("%v: needs <type> [name func]", p.fset.Position(n.Pos()))
+			}
+			nameFunc := ""
+			if 2 <= len(args) {
+				nameFunc = args[1]
+			}
+			rule := option.NewEnumString(args[0], nameFunc, n.Pos())
+			opts.EnumStrings = append(opts.EnumStrings, rule)
+		case "conv:with":
+			if len(args) < 2 {
+				return logger.Errorf("%v: needs <conv> <dst>", p.fset.Position(n.Pos()))
+			}
+			// The converter takes the source variable as a whole, so there is no source path.
+			converter := option.NewFieldConverter(args[0], "", args[1], n.Pos())
+			opts.StructConverters = append(opts.StructConverters, converter)
 		case "method", "method:err":
 			if len(args) < 2 {
 				return logger.Errorf("%v: needs <method> <src> <dst>", p.fset.Position(n.Pos()))
@@ -272,9 +423,10 @@ func (p *Parser) lookupType(
 func (p *Parser) resolveConverters(generatingMethods []*bmodel.MethodEntry, conv *option.FieldConverter) error {
 	name := conv.Converter()
 	pos := conv.Pos()
-	argType, retType, retError, err := p.lookupConverterFunc(name, pos)
+	argType, retType, retError, leading, trailing, err := p.lookupConverterFunc(name, pos)
 	if err == nil {
 		conv.Set(argType, retType, retError)
+		conv.SetParams(leading, trailing)
 		return nil
 	}
 
@@ -292,6 +444,7 @@ func (p *Parser) resolveConverters(generatingMethods []*bmodel.MethodEntry, conv
 			continue
 		}
 		conv.Set(method.SrcVar().Type(), method.DstVar().Type(), method.RetError())
+		conv.SetParams(method.ParamVarsAround(method.SrcVar()))
 		return nil
 	}
 
@@ -301,10 +454,128 @@ func (p *Parser) resolveConverters(generatingMethods []*bmodel.MethodEntry, conv
 	return err
 }
 
+// resolveTypeConverter resolves the converter function of the TypeConverter `conv` the same way
+// resolveConverters does, and then its source and destination types.
+// If the destination type is omitted, the return type of the converter is used.
+func (p *Parser) resolveTypeConverter(generatingMethods []*bmodel.MethodEntry, conv *option.TypeConverter) error {
+	if err := p.resolveConverters(generatingMethods, conv.FieldConverter); err != nil {
+		return err
+	}
+
+	pos := conv.Pos()
+	srcType, err := p.lookupTypeExpr(conv.SrcExpr(), pos)
+	if err != nil {
+		return err
+	}
+	if !types.AssignableTo(srcType, conv.ArgType()) && !types.AssignableTo(srcType, util.DerefPtr(conv.ArgType())) {
+		return logger.Errorf("%v: function %v cannot accept %v", p.fset.Position(pos), conv.Converter(), conv.SrcExpr())
+	}
+
+	dstType := conv.RetType()
+	if conv.DstExpr() != "" {
+		dstType, err = p.lookupTypeExpr(conv.DstExpr(), pos)
+		if err != nil {
+			return err
+		}
+		if !types.AssignableTo(conv.RetType(), dstType) {
+			return logger.Errorf("%v: function %v cannot return %v", p.fset.Position(pos), conv.Converter(), conv.DstExpr())
+		}
+	}
+
+	conv.SetTypes(srcType, dstType)
+	return nil
+}
+
+// resolveEnumString resolves the enum type of the EnumString `rule` and its constants, which are enumerated
+// the same way EnumConstValues does for the mask bits. It also checks the function that names the values, if any.
+func (p *Parser) resolveEnumString(rule *option.EnumString) error {
+	pos := rule.Pos()
+	enumType, err := p.lookupTypeExpr(rule.TypeExpr(), pos)
+	if err != nil {
+		return err
+	}
+
+	named, ok := enumType.(*types.Named)
+	basic, isBasic := enumType.Underlying().(*types.Basic)
+	if !ok || !isBasic || basic.Info()&types.IsInteger == 0 {
+		return logger.Errorf("%v: %v isn't a named integer type", p.fset.Position(pos), rule.TypeExpr())
+	}
+
+	pkg := p.pkg
+	if named.Obj().Pkg() != p.pkg.Types {
+		if pkg, ok = p.pkg.Imports[named.Obj().Pkg().Path()]; !ok {
+			return logger.Errorf("%v: package of %v not found", p.fset.Position(pos), rule.TypeExpr())
+		}
+	}
+	consts, err := util.EnumConsts(pkg, enumType)
+	if err != nil {
+		return logger.Errorf("%v: %v", p.fset.Position(pos), err)
+	}
+	if len(consts) == 0 {
+		return logger.Errorf("%v: %v has no constants", p.fset.Position(pos), rule.TypeExpr())
+	}
+
+	method := false
+	if rule.NameFunc() != "" {
+		if method, err = p.lookupEnumNameFunc(rule.NameFunc(), enumType, pos); err != nil {
+			return err
+		}
+	}
+	rule.Set(enumType, consts, method)
+	return nil
+}
+
+// lookupEnumNameFunc checks that name is either a method of enumType or a function that takes a value of it,
+// and that it returns a string. It returns true for a method.
+func (p *Parser) lookupEnumNameFunc(name string, enumType types.Type, pos token.Pos) (method bool, err error) {
+	var sig *types.Signature
+	if obj, _, _ := types.LookupFieldOrMethod(enumType, false, p.pkg.Types, name); obj != nil {
+		if fn, ok := obj.(*types.Func); ok && util.CompliesGetter(fn) {
+			sig, method = fn.Type().(*types.Signature), true
+		}
+	} else if _, obj, _ := p.lookupType(name, pos); obj != nil {
+		if s, ok := obj.Type().(*types.Signature); ok && s.Params().Len() == 1 && !s.Variadic() &&
+			types.AssignableTo(enumType, s.Params().At(0).Type()) {
+			sig = s
+		}
+	}
+
+	if sig == nil || sig.Results().Len() != 1 || !types.Identical(sig.Results().At(0).Type(), util.StringType()) {
+		return false, logger.Errorf("%v: %v cannot name the values of %v", p.fset.Position(pos), name, enumType)
+	}
+	return method, nil
+}
+
+// lookupTypeExpr looks up a type by the type expression such as "int64", "time.Time" or "*pkg.Type".
+func (p *Parser) lookupTypeExpr(expr string, pos token.Pos) (types.Type, error) {
+	if tv, err := types.Eval(p.fset, p.pkg.Types, pos, expr); err == nil && tv.IsType() {
+		return tv.Type, nil
+	}
+
+	// Packages that are imported only for the notations, e.g. `_ "time"`, are not in the scope.
+	typeName := strings.TrimLeft(expr, "*")
+	_, obj, _ := p.lookupType(typeName, pos)
+	if _, ok := obj.(*types.TypeName); !ok {
+		return nil, logger.Errorf("%v: type %v not found", p.fset.Position(pos), expr)
+	}
+
+	typ := obj.Type()
+	for i := len(typeName); i < len(expr); i++ {
+		typ = types.NewPointer(typ)
+	}
+	return typ, nil
+}
+
 // lookupConverterFunc finds and returns the argument and return types of a function
 // with the given name and position.
 // It checks that the function is a valid converter function and can be used as such.
-func (p *Parser) lookupConverterFunc(funcName string, pos token.Pos) (argType, retType types.Type, retError bool, err error) {
+//
+// The argument is the first parameter, or the second one if the first is context.Context.
+// The other parameters, except the variadic one, are returned as leading and trailing
+// so that the arguments of the generated function are passed to them.
+func (p *Parser) lookupConverterFunc(funcName string, pos token.Pos) (
+	argType, retType types.Type, retError bool, leading, trailing []*types.Var, err error,
+) {
 	_, obj, _ := p.lookupType(funcName, pos)
 	if obj == nil {
 		err = fmt.Errorf("%v: function %v not found", p.fset.Position(pos), funcName)
@@ -316,22 +587,13 @@ func (p *Parser) lookupConverterFunc(funcName string, pos token.Pos) (argType, r
 		return
 	}
 
-	// 支持convFunc(src Src, others ...Other)的形式
-	if sig.Params().Len() != 1 {
-		if sig.Params().Len() != 2 {
-			err = logger.Errorf(
-				"%v: function %v cannot use as a converter, params num is %d",
-				p.fset.Position(pos), funcName, sig.Params().Len(),
-			)
-			return
-		}
-		if !sig.Variadic() {
-			err = logger.Errorf(
-				"%v: function %v cannot use as a converter, last param must be variadic",
-				p.fset.Position(pos), funcName,
-			)
-			return
-		}
+	params := sig.Params()
+	if params.Len() == 0 {
+		err = logger.Errorf(
+			"%v: function %v cannot use as a converter, params num is %d",
+			p.fset.Position(pos), funcName, params.Len(),
+		)
+		return
 	}
 
 	if sig.Results().Len() < 1 || 2 < sig.Results().Len() {
@@ -343,7 +605,33 @@ func (p *Parser) lookupConverterFunc(funcName string, pos token.Pos) (argType, r
 		return
 	}
 
-	argType = sig.Params().At(0).Type()
+	argIndex := 0
+	if 2 <= params.Len() && util.IsContextType(params.At(0).Type()) {
+		argIndex = 1
+	}
+	if argIndex == params.Len()-1 && sig.Variadic() {
+		err = logger.Errorf(
+			"%v: function %v cannot use as a converter, argument cannot be variadic",
+			p.fset.Position(pos), funcName,
+		)
+		return
+	}
+
+	// 支持convFunc(src Src, others ...Other)的形式
+	// The variadic parameter is left empty.
+	end := params.Len()
+	if sig.Variadic() {
+		end--
+	}
+	for i := 0; i < end; i++ {
+		if i < argIndex {
+			leading = append(leading, params.At(i))
+		} else if argIndex < i {
+			trailing = append(trailing, params.At(i))
+		}
+	}
+
+	argType = params.At(argIndex).Type()
 	retType = sig.Results().At(0).Type()
 	retError = sig.Results().Len() == 2 && util.IsErrorType(sig.Results().At(1).Type())
 	return
@@ -362,17 +650,35 @@ func (p *Parser) lookupManipulatorFunc(funcName, optName string, pos token.Pos)
 		return nil, logger.Errorf("%v: %v isn't a function", p.fset.Position(pos), funcName)
 	}
 
-	if sig.Params().Len() != 2 ||
+	// The function takes (dst, src) optionally preceded by context.Context and followed by other parameters.
+	params := sig.Params()
+	dstIndex := 0
+	if 3 <= params.Len() && util.IsContextType(params.At(0).Type()) {
+		dstIndex = 1
+	}
+
+	if params.Len() < dstIndex+2 || sig.Variadic() ||
 		1 < sig.Results().Len() ||
 		(sig.Results().Len() == 1 && !util.IsErrorType(sig.Results().At(0).Type())) {
 		return nil, logger.Errorf("%v: function %v cannot use for %v func", p.fset.Position(pos), funcName, optName)
 	}
 
+	var leading, trailing []*types.Var
+	for i := 0; i < params.Len(); i++ {
+		if i < dstIndex {
+			leading = append(leading, params.At(i))
+		} else if dstIndex+1 < i {
+			trailing = append(trailing, params.At(i))
+		}
+	}
+
 	return &option.Manipulator{
-		Func:     obj,
-		DstSide:  sig.Params().At(0).Type(),
-		SrcSide:  sig.Params().At(1).Type(),
-		RetError: sig.Results().Len() == 1 && util.IsErrorType(sig.Results().At(0).Type()),
-		Pos:      pos,
+		Func:           obj,
+		DstSide:        params.At(dstIndex).Type(),
+		SrcSide:        params.At(dstIndex + 1).Type(),
+		LeadingParams:  leading,
+		TrailingParams: trailing,
+		RetError:       sig.Results().Len() == 1 && util.IsErrorType(sig.Results().At(0).Type()),
+		Pos:            pos,
 	}, nil
 }
@@ -27,6 +27,10 @@ func TestNotations(t *testing.T) {
 		t.Parallel()
 		testMethodNotations(t)
 	})
+	t.Run("mapping rules with ValidOpsIntf", func(t *testing.T) {
+		t.Parallel()
+		testMappingRuleNotations(t, option.ValidOpsIntf)
+	})
 }
 
 func testCommonNotations(t *testing.T, validOpts map[string]struct{}) {
@@ -86,6 +90,72 @@ func testCommonNotations(t *testing.T, validOpts map[string]struct{}) {
 			notation: ":typecast:off",
 			expected: func(opt *option.Options) { opt.Typecast = false },
 		},
+		{
+			notation: ":nil:skip",
+			expected: func(opt *option.Options) { opt.SkipNil = true },
+		},
+		{
+			notation: ":nil:keep",
+			expected: func(opt *option.Options) { opt.SkipNil = false },
+		},
+		{
+			notation: ":sqlnull",
+			expected: func(opt *option.Options) { opt.SQLNull = true },
+		},
+		{
+			notation: ":sqlnull:off",
+			expected: func(opt *option.Options) { opt.SQLNull = false },
+		},
+		{
+			notation: ":time unixmilli",
+			expected: func(opt *option.Options) { opt.Time, _ = option.NewTimeFormat("unixmilli") },
+		},
+		{
+			notation: ":time layout:2006-01-02 15:04",
+			expected: func(opt *option.Options) { opt.Time, _ = option.NewTimeFormat("layout:2006-01-02 15:04") },
+		},
+		{
+			notation: ":time:off",
+			expected: func(opt *option.Options) { opt.Time = nil },
+		},
+		{
+			notation: ":proto",
+			expected: func(opt *option.Options) { opt.Proto = true },
+		},
+		{
+			notation: ":proto:off",
+			expected: func(opt *option.Options) { opt.Proto = false },
+		},
+		{
+			notation: ":enum Status Status_STATUS",
+			expected: func(opt *option.Options) {
+				opt.Enum = true
+				opt.EnumPrefixes = []string{"Status", "Status_STATUS"}
+			},
+		},
+		{
+			notation: ":enum:off",
+			expected: func(opt *option.Options) {
+				opt.Enum = false
+				opt.EnumPrefixes = nil
+			},
+		},
+		{
+			notation: ":strict",
+			expected: func(opt *option.Options) { opt.Strict = true },
+		},
+		{
+			notation: ":strict:off",
+			expected: func(opt *option.Options) { opt.Strict = false },
+		},
+		{
+			notation: ":exhaustive:src",
+			expected: func(opt *option.Options) { opt.ExhaustiveSrc = true },
+		},
+		{
+			notation: ":exhaustive:src:off",
+			expected: func(opt *option.Options) { opt.ExhaustiveSrc = false },
+		},
 	}
 
 	p, err := NewParser(
@@ -121,10 +191,73 @@ func testMethodNotations(t *testing.T) {
 			notation:  ":skip Name",
 			validator: func(opt option.Options) bool { return len(opt.SkipFields) == 1 },
 		},
+		{
+			notation: ":ignore:src Password /At$/",
+			validator: func(opt option.Options) bool {
+				return opt.ShouldIgnoreSrc("Password") && opt.ShouldIgnoreSrc("CreatedAt")
+			},
+		},
 		{
 			notation:  ":map ID UserID",
 			validator: func(opt option.Options) bool { return len(opt.NameMapper) == 1 },
 		},
+		{
+			notation:  ":map /^Src(.*)$/ $1",
+			validator: func(opt option.Options) bool { return len(opt.RenameRules) == 1 && len(opt.NameMapper) == 0 },
+		},
+		{
+			notation:  ":map:prefix Biz",
+			validator: func(opt option.Options) bool { return len(opt.RenameRules) == 1 },
+		},
+		{
+			notation: ":match tag db name",
+			validator: func(opt option.Options) bool {
+				return opt.Rule == model.MatchRuleTag && opt.TagKey() == "db" && opt.MatchTagFallback
+			},
+		},
+		{
+			notation: ":match normalized prefix=m_ suffix=Col",
+			validator: func(opt option.Options) bool {
+				return opt.Rule == model.MatchRuleNormalized &&
+					len(opt.TrimPrefixes) == 1 && opt.TrimPrefixes[0] == "m_" &&
+					len(opt.TrimSuffixes) == 1 && opt.TrimSuffixes[0] == "Col"
+			},
+		},
+		{
+			notation: ":conv:type TimeToMillis time.Time int64",
+			validator: func(opt option.Options) bool {
+				return len(opt.TypeConverters) == 1 &&
+					opt.TypeConverters[0].SrcExpr() == "time.Time" &&
+					opt.TypeConverters[0].DstExpr() == "int64"
+			},
+		},
+		{
+			notation: ":enum:string domain.Status String",
+			validator: func(opt option.Options) bool {
+				return len(opt.EnumStrings) == 1 &&
+					opt.EnumStrings[0].TypeExpr() == "domain.Status" &&
+					opt.EnumStrings[0].NameFunc() == "String"
+			},
+		},
+		{
+			notation: ":conv:with FullName Name",
+			validator: func(opt option.Options) bool {
+				return len(opt.StructConverters) == 1 &&
+					opt.StructConverters[0].Dst().Match("Name", true)
+			},
+		},
+		{
+			notation: ":src user profile",
+			validator: func(opt option.Options) bool {
+				return len(opt.Sources) == 2 && opt.Sources[0] == "user" && opt.Sources[1] == "profile"
+			},
+		},
+		{
+			notation: ":precedence profile user",
+			validator: func(opt option.Options) bool {
+				return len(opt.Precedence) == 2 && opt.Precedence[0] == "profile"
+			},
+		},
 	}
 
 	p, err := NewParser(
@@ -145,10 +278,35 @@ func testMethodNotations(t *testing.T) {
 	}
 }
 
+func testMappingRuleNotations(t *testing.T, validOpts map[string]struct{}) {
+	p, err := NewParser(
+		&config.Config{
+			Input:  "../../tests/fixtures/usecase/getter/setup.go",
+			Output: "../../tests/fixtures/usecase/getter/setup.gen.go",
+		},
+	)
+	require.Nil(t, err)
+
+	actual := option.NewOptions()
+	notations := []*ast.Comment{
+		{Text: "// :map ID UserID"},
+		{Text: "// :conv strings.ToUpper Name"},
+		{Text: "// :method:err Validate Code"},
+		{Text: "// :literal Version 1"},
+	}
+	err = p.parseNotationInComments(notations, validOpts, &actual)
+	require.Nil(t, err)
+
+	assert.Len(t, actual.NameMapper, 1)
+	assert.Len(t, actual.Converters, 1)
+	assert.Len(t, actual.Methods, 1)
+	assert.Len(t, actual.Literals, 1)
+}
+
 func assertOptionsEquals(t *testing.T, a, b option.Options, msg string) {
 	t.Helper()
 	cmpOpts := []cmp.Option{
-		cmp.AllowUnexported(option.Options{}),
+		cmp.AllowUnexported(option.Options{}, option.TimeFormat{}),
 	}
 
 	assert.True(
@@ -158,3 +316,68 @@ func assertOptionsEquals(t *testing.T, a, b option.Options, msg string) {
 		msg,
 	)
 }
+
+func TestLookupConverterFuncWithArgs(t *testing.T) {
+	t.Parallel()
+
+	p, err := NewParser(
+		&config.Config{
+			Input:  "../../tests/fixtures/usecase/ctxarg/setup.go",
+			Output: "../../tests/fixtures/usecase/ctxarg/setup.gen.go",
+		},
+	)
+	require.Nil(t, err)
+
+	pos := p.file.Name.Pos()
+	argType, retType, retError, leading, trailing, err := p.lookupConverterFunc("Localize", pos)
+	require.Nil(t, err)
+	assert.Equal(t, "string", argType.String())
+	assert.Equal(t, "string", retType.String())
+	assert.False(t, retError)
+	require.Len(t, leading, 1)
+	assert.Equal(t, "context.Context", leading[0].Type().String())
+	require.Len(t, trailing, 1)
+	assert.Equal(t, "string", trailing[0].Type().String())
+	assert.Equal(t, "locale", trailing[0].Name())
+
+	m, err := p.lookupManipulatorFunc("CheckAccess", "preprocess", pos)
+	require.Nil(t, err)
+	assert.Len(t, m.LeadingParams, 1)
+	assert.Empty(t, m.TrailingParams)
+	assert.True(t, m.RetError)
+
+	m, err = p.lookupManipulatorFunc("SetLocale", "postprocess", pos)
+	require.Nil(t, err)
+	assert.Empty(t, m.LeadingParams)
+	assert.Len(t, m.TrailingParams, 1)
+}
+
+func TestResolveEnumString(t *testing.T) {
+	t.Parallel()
+
+	p, err := NewParser(
+		&config.Config{
+			Input:  "../../tests/fixtures/usecase/enumstr/setup.go",
+			Output: "../../tests/fixtures/usecase/enumstr/setup.gen.go",
+		},
+	)
+	require.Nil(t, err)
+
+	pos := p.file.Name.Pos()
+	rule := option.NewEnumString("domain.Status", "", pos)
+	require.Nil(t, p.resolveEnumString(rule))
+	var names []string
+	for _, c := range rule.Consts() {
+		names = append(names, c.Name())
+	}
+	assert.Equal(t, []string{"StatusActive", "StatusSuspended", "StatusDeleted", "StatusDefault"}, names)
+	assert.False(t, rule.IsMethod())
+
+	rule = option.NewEnumString("domain.Role", "Label", pos)
+	require.Nil(t, p.resolveEnumString(rule))
+	assert.Len(t, rule.Consts(), 3)
+	assert.True(t, rule.IsMethod())
+
+	assert.NotNil(t, p.resolveEnumString(option.NewEnumString("domain.Role", "Name", pos)))
+	assert.NotNil(t, p.resolveEnumString(option.NewEnumString("string", "", pos)))
+}
@@ -23,6 +23,13 @@ type intfEntry struct {
 // The target interface form either in the name of "Convergen" or having ":convergen" notation in its Doc comments.
 // For them, this function also parses notations in their doc comments.
 func (p *Parser) findConvergenEntries() ([]*intfEntry, error) {
+	if err := p.findPresets(); err != nil {
+		return nil, err
+	}
+	if err := p.parseProjectNotations(); err != nil {
+		return nil, err
+	}
+
 	entries := make([]*intfEntry, 0)
 	scope := p.pkg.Types.Scope()
 	for _, name := range scope.Names() {
@@ -38,6 +45,9 @@ func (p *Parser) findConvergenEntries() ([]*intfEntry, error) {
 			// Skip other than the entry file.
 			continue
 		}
+		if p.isPreset(obj) {
+			continue
+		}
 
 		docComment, cleanUp := util.GetDocCommentOn(p.file, obj)
 
@@ -55,7 +65,7 @@ func (p *Parser) findConvergenEntries() ([]*intfEntry, error) {
 		}
 		cleanUp()
 
-		opts := p.opts
+		opts := p.opts.Clone()
 		err := p.parseNotationInComments(notations, option.ValidOpsIntf, &opts)
 		if err != nil {
 			return nil, err
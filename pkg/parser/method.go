@@ -3,6 +3,7 @@ package parser
 import (
 	"errors"
 	"fmt"
+	"go/token"
 	"go/types"
 	"os"
 	"regexp"
@@ -29,7 +30,7 @@ func (p *Parser) parseMethods(intf *intfEntry) ([]*model.MethodEntry, error) {
 	methods := make([]*model.MethodEntry, 0)
 	for i := 0; i < mset.Len(); i++ {
 		// 解析interface里面的单个function
-		method, err := p.parseMethod(mset.At(i).Obj(), intf.opts)
+		method, err := p.parseMethod(mset.At(i).Obj(), intf.opts.Clone())
 		if err != nil {
 			_, _ = fmt.Fprintln(os.Stderr, err.Error())
 			continue
@@ -58,6 +59,11 @@ func (p *Parser) parseMethod(method types.Object, opts option.Options) (*model.M
 		return nil, logger.Errorf(`%v: method must have one or more return values as copy destination`, p.fset.Position(method.Pos()))
 	}
 
+	// The mapping rules declared on the interface are set aside and appended after
+	// the method's own ones, so that the method-level rules take precedence.
+	inherited := opts
+	opts.NameMapper, opts.Converters, opts.Literals, opts.Methods = nil, nil, nil, nil
+
 	docComment, cleanUp := util.GetDocCommentOn(p.file, method)
 	notations := util.ExtractMatchComments(docComment, reNotation)
 	// 解析interface里面的单个function注释中， 所有conv选项
@@ -65,6 +71,7 @@ func (p *Parser) parseMethod(method types.Object, opts option.Options) (*model.M
 	if err != nil {
 		return nil, err
 	}
+	inheritRules(&opts, inherited)
 
 	cleanUp()
 
@@ -74,3 +81,52 @@ func (p *Parser) parseMethod(method types.Object, opts option.Options) (*model.M
 		DocComment: docComment,
 	}, nil
 }
+
+// inheritRules appends the interface-level mapping rules in inherited to opts.
+// A rule is not inherited if the method has its own rule for the same destination.
+func inheritRules(opts *option.Options, inherited option.Options) {
+	ownDsts := make(map[string]struct{})
+	for _, m := range opts.NameMapper {
+		ownDsts[m.Dst().Pattern()] = struct{}{}
+	}
+	for _, c := range opts.Converters {
+		ownDsts[c.Dst().Pattern()] = struct{}{}
+	}
+	for _, l := range opts.Literals {
+		ownDsts[l.Dst().Pattern()] = struct{}{}
+	}
+	for _, m := range opts.Methods {
+		ownDsts[m.Dst().Pattern()] = struct{}{}
+	}
+
+	overridden := func(dst *option.IdentMatcher) bool {
+		_, ok := ownDsts[dst.Pattern()]
+		return ok
+	}
+
+	opts.InheritedRules = make(map[token.Pos]struct{})
+	for _, m := range inherited.NameMapper {
+		if !overridden(m.Dst()) {
+			opts.NameMapper = append(opts.NameMapper, m)
+			opts.InheritedRules[m.Pos()] = struct{}{}
+		}
+	}
+	for _, c := range inherited.Converters {
+		if !overridden(c.Dst()) {
+			opts.Converters = append(opts.Converters, c)
+			opts.InheritedRules[c.Pos()] = struct{}{}
+		}
+	}
+	for _, l := range inherited.Literals {
+		if !overridden(l.Dst()) {
+			opts.Literals = append(opts.Literals, l)
+			opts.InheritedRules[l.Pos()] = struct{}{}
+		}
+	}
+	for _, m := range inherited.Methods {
+		if !overridden(m.Dst()) {
+			opts.Methods = append(opts.Methods, m)
+			opts.InheritedRules[m.Pos()] = struct{}{}
+		}
+	}
+}
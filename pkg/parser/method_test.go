@@ -0,0 +1,35 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/reedom/convergen/pkg/option"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInheritRules(t *testing.T) {
+	t.Parallel()
+
+	inherited := option.NewOptions()
+	inherited.NameMapper = []*option.NameMatcher{option.NewNameMatcher("Email", "Mail", 1)}
+	inherited.Converters = []*option.FieldConverter{option.NewFieldConverter("upper", "Name", "Name", 2)}
+	inherited.Literals = []*option.LiteralSetter{option.NewLiteralSetter("Version", "1", 3)}
+
+	opts := option.NewOptions()
+	opts.Literals = []*option.LiteralSetter{option.NewLiteralSetter("Name", `"fixed"`, 4)}
+	inheritRules(&opts, inherited)
+
+	require.Len(t, opts.NameMapper, 1)
+	assert.True(t, opts.IsInherited(opts.NameMapper[0].Pos()))
+
+	// The method sets Name by itself, so the inherited converter for Name is dropped.
+	assert.Empty(t, opts.Converters)
+
+	// The method's own rules come first.
+	require.Len(t, opts.Literals, 2)
+	assert.Equal(t, `"fixed"`, opts.Literals[0].Literal())
+	assert.False(t, opts.IsInherited(opts.Literals[0].Pos()))
+	assert.Equal(t, "1", opts.Literals[1].Literal())
+	assert.True(t, opts.IsInherited(opts.Literals[1].Pos()))
+}
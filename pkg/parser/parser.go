@@ -5,13 +5,13 @@ import (
 	"errors"
 	"fmt"
 	"go/ast"
-	"go/constant"
 	"go/parser"
 	"go/printer"
 	"go/token"
 	"go/types"
 	"os"
 	"regexp"
+	"strings"
 
 	"github.com/reedom/convergen/pkg/builder"
 	"github.com/reedom/convergen/pkg/builder/model"
@@ -26,13 +26,15 @@ const buildTag = "convergen"
 
 // Parser represents a parser for a Go source file that contains convergen blocks.
 type Parser struct {
-	srcPath     string            // The path to the source file being parsed.
-	file        *ast.File         // The parsed AST of the source file.
-	fset        *token.FileSet    // The token file set used for parsing.
-	pkg         *packages.Package // The package information for the parsed file.
-	opts        option.Options    // The options for the parser.
-	imports     util.ImportNames  // The import names used in the parsed file.
-	intfEntries []*intfEntry      // The interface entries parsed from the file.
+	srcPath     string                  // The path to the source file being parsed.
+	file        *ast.File               // The parsed AST of the source file.
+	fset        *token.FileSet          // The token file set used for parsing.
+	pkg         *packages.Package       // The package information for the parsed file.
+	opts        option.Options          // The options for the parser.
+	imports     util.ImportNames        // The import names used in the parsed file.
+	intfEntries []*intfEntry            // The interface entries parsed from the file.
+	presets     map[string]*presetEntry // The presets declared in the file, keyed by name.
+	project     *config.File            // The project configuration file, or nil.
 }
 
 // parserLoadMode is a packages.Load mode that loads types and syntax trees.
@@ -53,6 +55,7 @@ func NewParser(conf *config.Config) (*Parser, error) {
 	opts.ExactCase = conf.ExactCase
 	opts.Stringer = conf.Stringer
 	opts.Typecast = conf.Typecast
+	opts.Strict = conf.Strict
 
 	srcStat, err := os.Stat(srcPath)
 	if err != nil {
@@ -63,7 +66,7 @@ func NewParser(conf *config.Config) (*Parser, error) {
 	var parseErr error
 	cfg := &packages.Config{
 		Mode:       parserLoadMode,
-		BuildFlags: []string{"-tags", buildTag},
+		BuildFlags: []string{"-tags", strings.Join(append([]string{buildTag}, conf.Tags...), ",")},
 		Fset:       fileSet,
 		ParseFile: func(fset *token.FileSet, filename string, src []byte) (*ast.File, error) {
 			stat, err := os.Stat(filename)
@@ -109,6 +112,7 @@ func NewParser(conf *config.Config) (*Parser, error) {
 		pkg:     pkgs[0],
 		opts:    opts,
 		imports: util.NewImportNames(fileSrc.Imports),
+		project: conf.File,
 	}, nil
 }
 
@@ -147,12 +151,38 @@ func (p *Parser) Parse() ([]*model.MethodsInfo, error) {
 				return nil, err
 			}
 		}
+		for _, conv := range method.Opts.StructConverters {
+			err = p.resolveConverters(allMethods, conv)
+			if err != nil {
+				return nil, err
+			}
+		}
+		for _, conv := range method.Opts.TypeConverters {
+			err = p.resolveTypeConverter(allMethods, conv)
+			if err != nil {
+				return nil, err
+			}
+		}
+		for _, rule := range method.Opts.EnumStrings {
+			err = p.resolveEnumString(rule)
+			if err != nil {
+				return nil, err
+			}
+		}
 
 		if err := p.resolveMaskConverter(method); err != nil {
 			return nil, err
 		}
 	}
 
+	tagConverters, err := p.resolveTagConverters(allMethods)
+	if err != nil {
+		return nil, err
+	}
+	for _, method := range allMethods {
+		method.Opts.TagConverters = tagConverters
+	}
+
 	p.intfEntries = entries
 	return list, nil
 }
@@ -311,34 +341,15 @@ func (p *Parser) EnumConstValues(
 		return nil, fmt.Errorf("target const has no type")
 	}
 
-	// 2. 遍历包中所有定义的对象，筛选同类型常量
-	result := []string{}
-
-	for _, obj := range pkg.TypesInfo.Defs {
-		if obj == nil {
-			continue // 跳过未定义的标识符
-		}
-
-		// 检查是否为常量
-		c, ok := obj.(*types.Const)
-		if !ok {
-			continue
-		}
-
-		// 检查类型是否与目标常量一致
-		if !types.Identical(c.Type(), targetType) {
-			continue
-		}
-
-		// 3. 提取常量值（假设为 int 类型，其他类型需适配）
-		val := c.Val()
-		if val.Kind() != constant.Int {
-			return nil, fmt.Errorf("const %s is not an integer", c.Name())
-		}
-
-		result = append(result, c.Name())
+	consts, err := util.EnumConsts(pkg, targetType)
+	if err != nil {
+		return nil, err
 	}
 
+	result := make([]string, len(consts))
+	for i, c := range consts {
+		result[i] = c.Name()
+	}
 	return result, nil
 }
 
@@ -550,6 +561,11 @@ func (p *Parser) CreateBuilder() *builder.FunctionBuilder {
 func (p *Parser) GenerateBaseCode() (code string, err error) {
 	util.RemoveMatchComments(p.file, reGoBuildGen)
 
+	// Presets are only for the notations.
+	for _, preset := range p.presets {
+		util.RemoveDecl(p.file, preset.intf.Name())
+	}
+
 	// Remove doc comment of the interface.
 	// And also find the range pos of the interface in the code.
 	for _, entry := range p.intfEntries {
@@ -0,0 +1,116 @@
+package parser
+
+import (
+	"go/ast"
+	"go/types"
+	"regexp"
+	"strings"
+
+	"github.com/reedom/convergen/pkg/logger"
+	"github.com/reedom/convergen/pkg/util"
+)
+
+// rePreset is a regular expression that matches a notation that
+// declares a preset of notations.
+var rePreset = regexp.MustCompile(`^\s*//\s*:preset\s+(\S+)\s*$`)
+
+// presetEntry represents a named set of notations declared with ":preset".
+type presetEntry struct {
+	intf      types.Object   // intf represents the interface that holds the preset.
+	notations []*ast.Comment // notations represents the notations in the preset.
+}
+
+// findPresets collects presets from the setup file.
+// A preset is an interface that has ":preset <name>" notation in its doc comments,
+// and the rest of the notations there are the contents of the preset.
+func (p *Parser) findPresets() error {
+	p.presets = make(map[string]*presetEntry)
+	scope := p.pkg.Types.Scope()
+	for _, name := range scope.Names() {
+		obj := scope.Lookup(name)
+		if _, ok := obj.Type().Underlying().(*types.Interface); !ok {
+			continue
+		}
+		if p.srcPath != p.fset.Position(obj.Pos()).Filename {
+			continue
+		}
+
+		docComment, cleanUp := util.GetDocCommentOn(p.file, obj)
+		decl := util.ExtractMatchComments(docComment, rePreset)
+		if len(decl) == 0 {
+			cleanUp()
+			continue
+		}
+		if 1 < len(decl) {
+			return logger.Errorf("%v: an interface can declare only one preset", p.fset.Position(decl[1].Pos()))
+		}
+
+		presetName := rePreset.FindStringSubmatch(decl[0].Text)[1]
+		if !isValidIdentifier(presetName) {
+			return logger.Errorf("%v: invalid preset name %v", p.fset.Position(decl[0].Pos()), presetName)
+		}
+		if dup, ok := p.presets[presetName]; ok {
+			return logger.Errorf("%v: preset %v is already declared at %v",
+				p.fset.Position(decl[0].Pos()), presetName, p.fset.Position(dup.intf.Pos()))
+		}
+
+		logger.Printf("%v: preset found: %v", p.fset.Position(obj.Pos()), presetName)
+		notations := util.ExtractMatchComments(docComment, reNotation)
+		docComment.List = nil
+		cleanUp()
+
+		p.presets[presetName] = &presetEntry{intf: obj, notations: notations}
+	}
+	return nil
+}
+
+// isPreset reports whether obj is an interface that holds a preset.
+func (p *Parser) isPreset(obj types.Object) bool {
+	for _, preset := range p.presets {
+		if preset.intf == obj {
+			return true
+		}
+	}
+	return false
+}
+
+// expandPresets replaces ":use <preset>..." notations with the notations of the presets.
+// The notations of the presets come first so that the rest of the notations can override them.
+func (p *Parser) expandPresets(notations []*ast.Comment, validOps map[string]struct{}, using []string) ([]*ast.Comment, error) {
+	var expanded, own []*ast.Comment
+	for _, n := range notations {
+		m := reNotation.FindStringSubmatch(n.Text)
+		if len(m) < 2 || m[1] != "use" {
+			own = append(own, n)
+			continue
+		}
+		if _, ok := validOps[m[1]]; !ok {
+			// Leave it to parseNotationInComments to report.
+			own = append(own, n)
+			continue
+		}
+
+		names := strings.Fields(m[2])
+		if len(names) == 0 {
+			return nil, logger.Errorf("%v: needs <preset> args", p.fset.Position(n.Pos()))
+		}
+		for _, name := range names {
+			preset, ok := p.presets[name]
+			if !ok {
+				return nil, logger.Errorf("%v: preset %v not found", p.fset.Position(n.Pos()), name)
+			}
+			for _, u := range using {
+				if u == name {
+					return nil, logger.Errorf("%v: preset %v uses itself", p.fset.Position(n.Pos()), name)
+				}
+			}
+
+			list, err := p.expandPresets(preset.notations, validOps, append(using[:len(using):len(using)], name))
+			if err != nil {
+				return nil, err
+			}
+			expanded = append(expanded, list...)
+		}
+	}
+	return append(expanded, own...), nil
+}
@@ -0,0 +1,48 @@
+package parser
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/reedom/convergen/pkg/config"
+	"github.com/reedom/convergen/pkg/option"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPresets(t *testing.T) {
+	t.Parallel()
+
+	p, err := NewParser(
+		&config.Config{
+			Input:  "../../tests/fixtures/usecase/preset/setup.go",
+			Output: "../../tests/fixtures/usecase/preset/setup.gen.go",
+		},
+	)
+	require.Nil(t, err)
+	require.Nil(t, p.findPresets())
+	require.Contains(t, p.presets, "timestamps")
+	assert.Len(t, p.presets["timestamps"].notations, 2)
+
+	t.Run("use", func(t *testing.T) {
+		opts := option.NewOptions()
+		notations := []*ast.Comment{
+			{Text: "// :conv toMillis Created"},
+			{Text: "// :use timestamps"},
+		}
+		err := p.parseNotationInComments(notations, option.ValidOpsMethod, &opts)
+		require.Nil(t, err)
+
+		// The notations of the preset come first.
+		require.Len(t, opts.Converters, 3)
+		assert.Equal(t, "toUnix", opts.Converters[0].Converter())
+		assert.Equal(t, "toMillis", opts.Converters[2].Converter())
+	})
+
+	t.Run("unknown preset", func(t *testing.T) {
+		opts := option.NewOptions()
+		notations := []*ast.Comment{{Text: "// :use unknown"}}
+		err := p.parseNotationInComments(notations, option.ValidOpsMethod, &opts)
+		assert.ErrorContains(t, err, "preset unknown not found")
+	})
+}
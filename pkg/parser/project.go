@@ -0,0 +1,27 @@
+package parser
+
+import (
+	"go/ast"
+	"go/token"
+
+	"github.com/reedom/convergen/pkg/option"
+)
+
+// parseProjectNotations parses the notations in the project configuration file
+// as the defaults of the interface-level notations.
+// The file is registered to the fileset so that the notations have their positions in it.
+func (p *Parser) parseProjectNotations() error {
+	if p.project == nil || len(p.project.Notations) == 0 {
+		return nil
+	}
+
+	file := p.fset.AddFile(p.project.Path, -1, len(p.project.Source))
+	file.SetLinesForContent(p.project.Source)
+
+	notations := make([]*ast.Comment, len(p.project.Notations))
+	for i, n := range p.project.Notations {
+		pos := file.LineStart(n.Line) + token.Pos(n.Column-1)
+		notations[i] = &ast.Comment{Slash: pos, Text: "// " + n.Text}
+	}
+	return p.parseNotationInComments(notations, option.ValidOpsIntf, &p.opts)
+}
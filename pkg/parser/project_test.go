@@ -0,0 +1,46 @@
+package parser
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/reedom/convergen/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectNotations(t *testing.T) {
+	t.Parallel()
+
+	conf := &config.Config{
+		Input:  "../../tests/fixtures/usecase/project/setup.go",
+		Output: "../../tests/fixtures/usecase/project/setup.gen.go",
+	}
+	require.Nil(t, conf.LoadFile("../../tests/fixtures/usecase/project/convergen.yaml"))
+
+	p, err := NewParser(conf)
+	require.Nil(t, err)
+	require.Nil(t, p.parseProjectNotations())
+	assert.True(t, p.opts.Typecast)
+	assert.True(t, p.opts.ShouldSkip("Password"))
+	assert.Len(t, p.opts.NameMapper, 1)
+}
+
+func TestProjectNotationsError(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), config.FileName)
+	require.Nil(t, os.WriteFile(path, []byte("notations:\n  - \":typecast\"\n  - \":style bogus\"\n"), 0o644))
+
+	conf := &config.Config{
+		Input:  "../../tests/fixtures/usecase/project/setup.go",
+		Output: "../../tests/fixtures/usecase/project/setup.gen.go",
+	}
+	require.Nil(t, conf.LoadFile(path))
+
+	p, err := NewParser(conf)
+	require.Nil(t, err)
+	err = p.parseProjectNotations()
+	assert.ErrorContains(t, err, config.FileName+":3:5: invalid <style> arg")
+}
@@ -0,0 +1,99 @@
+package parser
+
+import (
+	"go/types"
+	"reflect"
+	"strings"
+
+	bmodel "github.com/reedom/convergen/pkg/builder/model"
+	"github.com/reedom/convergen/pkg/logger"
+	"github.com/reedom/convergen/pkg/option"
+	"github.com/reedom/convergen/pkg/util"
+)
+
+// resolveTagConverters validates the `convergen` struct tags of the fields that the methods' parameters
+// and results have, and resolves the converters they specify.
+func (p *Parser) resolveTagConverters(methods []*bmodel.MethodEntry) (map[*types.Var]*option.FieldConverter, error) {
+	converters := make(map[*types.Var]*option.FieldConverter)
+	visited := make(map[types.Type]struct{})
+
+	var walk func(t types.Type) error
+	walk = func(t types.Type) error {
+		t = util.DerefPtr(t)
+		if elem := util.SliceElement(t); elem != nil {
+			return walk(elem)
+		}
+		if _, ok := visited[t]; ok {
+			return nil
+		}
+		visited[t] = struct{}{}
+
+		strct, ok := t.Underlying().(*types.Struct)
+		if !ok {
+			return nil
+		}
+		for i := 0; i < strct.NumFields(); i++ {
+			field := strct.Field(i)
+			if value, ok := reflect.StructTag(strct.Tag(i)).Lookup(option.TagKey); ok {
+				tag, err := option.ParseFieldTag(value)
+				if err != nil {
+					return logger.Errorf("%v: %v", p.fset.Position(field.Pos()), err)
+				}
+				if tag.Conv != "" {
+					conv, err := p.resolveTagConverter(tag.Conv, field, t)
+					if err != nil {
+						return err
+					}
+					converters[field] = conv
+				}
+			}
+			if err := walk(field.Type()); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for _, method := range methods {
+		sig := method.Method.Type().(*types.Signature)
+		for _, tuple := range []*types.Tuple{sig.Params(), sig.Results()} {
+			for i := 0; i < tuple.Len(); i++ {
+				if err := walk(tuple.At(i).Type()); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	return converters, nil
+}
+
+// resolveTagConverter resolves the converter function `name` in the tag of the field that the struct `owner` has.
+// An unqualified name refers to a function in the setup file's package, or else in the owner's package.
+func (p *Parser) resolveTagConverter(name string, field *types.Var, owner types.Type) (*option.FieldConverter, error) {
+	// The field may be in another package, so look up the name from the setup file.
+	lookupPos := p.file.Name.Pos()
+
+	expr := name
+	if !strings.Contains(name, ".") {
+		if _, obj, _ := p.lookupType(name, lookupPos); obj == nil {
+			if pkg := util.PkgOf(owner); pkg != nil && pkg.Scope().Lookup(name) != nil {
+				if pkgName, ok := p.imports.LookupName(pkg.Path()); ok {
+					expr = pkgName + "." + name
+				}
+			}
+		}
+	}
+
+	if _, obj, _ := p.lookupType(expr, lookupPos); obj == nil {
+		return nil, logger.Errorf("%v: converter %v not found", p.fset.Position(field.Pos()), name)
+	}
+	argType, retType, retError, leading, trailing, err := p.lookupConverterFunc(expr, lookupPos)
+	if err != nil {
+		return nil, err
+	}
+
+	conv := option.NewFieldConverter(expr, field.Name(), field.Name(), field.Pos())
+	conv.Set(argType, retType, retError)
+	conv.SetParams(leading, trailing)
+	return conv, nil
+}
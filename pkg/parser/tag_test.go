@@ -0,0 +1,35 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/reedom/convergen/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveTagConverters(t *testing.T) {
+	t.Parallel()
+
+	p, err := NewParser(
+		&config.Config{
+			Input:  "../../tests/fixtures/usecase/fieldtag/setup.go",
+			Output: "../../tests/fixtures/usecase/fieldtag/setup.gen.go",
+		},
+	)
+	require.Nil(t, err)
+
+	list, err := p.Parse()
+	require.Nil(t, err)
+	require.NotEmpty(t, list)
+
+	converters := list[0].Methods[0].Opts.TagConverters
+	require.Len(t, converters, 2)
+
+	names := make(map[string]string)
+	for field, conv := range converters {
+		names[field.Name()] = conv.Converter()
+	}
+	assert.Equal(t, "toMillis", names["CreatedAt"])
+	assert.Equal(t, "strconv.Itoa", names["ID"])
+}
@@ -57,6 +57,7 @@ func Run(conf config.Config) error {
 	}
 
 	code := model.Code{
+		Header:         conf.Header,
 		BaseCode:       baseCode,
 		FunctionBlocks: funcBlocks,
 	}
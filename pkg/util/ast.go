@@ -55,20 +55,47 @@ func ExtractMatchComments(commentGroup *ast.CommentGroup, pattern *regexp.Regexp
 }
 
 // RemoveDecl removes a declaration named name from file.Decls.
+// Other declarations in the same group, such as "type ( ... )", are kept.
 func RemoveDecl(file *ast.File, name string) {
-	comparer := func(s string) bool {
-		return s == name
-	}
-
-	decls := make([]ast.Decl, 0)
+	decls := make([]ast.Decl, 0, len(file.Decls))
 	for _, decl := range file.Decls {
-		if !ast.FilterDecl(decl, comparer) {
-			decls = append(decls, decl)
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			if d.Recv == nil && d.Name.Name == name {
+				continue
+			}
+		case *ast.GenDecl:
+			specs := make([]ast.Spec, 0, len(d.Specs))
+			for _, spec := range d.Specs {
+				if !specHasName(spec, name) {
+					specs = append(specs, spec)
+				}
+			}
+			if len(specs) == 0 && len(d.Specs) > 0 {
+				continue
+			}
+			d.Specs = specs
 		}
+		decls = append(decls, decl)
 	}
 	file.Decls = decls
 }
 
+// specHasName reports whether spec declares name.
+func specHasName(spec ast.Spec, name string) bool {
+	switch s := spec.(type) {
+	case *ast.TypeSpec:
+		return s.Name.Name == name
+	case *ast.ValueSpec:
+		for _, n := range s.Names {
+			if n.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // InsertComment inserts a comment with the specified text at the specified
 // position in file.Comments.
 func InsertComment(file *ast.File, text string, pos token.Pos) {
@@ -211,6 +211,34 @@ func TestRemoveDecl(t *testing.T) {
 	assert.True(t, foundMain, "Expected declaration 'main' remains, but it doesn't")
 }
 
+func TestRemoveDeclType(t *testing.T) {
+	source := `
+		package main
+
+		import "fmt"
+
+		type preset interface{}
+
+		type (
+			foo struct{}
+			bar struct{}
+		)
+	`
+	fileSet := token.NewFileSet()
+	file, err := parser.ParseFile(fileSet, "test.go", source, parser.AllErrors)
+	assert.NoError(t, err)
+
+	util.RemoveDecl(file, "preset")
+	util.RemoveDecl(file, "foo")
+
+	var buf bytes.Buffer
+	assert.NoError(t, printer.Fprint(&buf, fileSet, file))
+	assert.NotContains(t, buf.String(), "preset")
+	assert.NotContains(t, buf.String(), "foo")
+	assert.Contains(t, buf.String(), "bar struct{}")
+	assert.Contains(t, buf.String(), `import "fmt"`)
+}
+
 func TestInsertComment(t *testing.T) {
 	// Define the source code to test.
 	source := `
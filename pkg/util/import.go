@@ -68,22 +68,46 @@ func (i ImportNames) LookupPath(pkgName string) (path string, ok bool) {
 }
 
 // TypeName returns a string representation of the given type with its package name.
+// Type arguments of an instantiated generic type are rendered in the same manner.
 func (i ImportNames) TypeName(t types.Type) string {
 	switch typ := t.(type) {
 	case *types.Pointer:
 		return "*" + i.TypeName(typ.Elem())
 	case *types.Basic:
 		return typ.Name()
+	case *types.Slice:
+		return "[]" + i.TypeName(typ.Elem())
+	case *types.Array:
+		return fmt.Sprintf("[%v]%v", typ.Len(), i.TypeName(typ.Elem()))
+	case *types.Map:
+		return fmt.Sprintf("map[%v]%v", i.TypeName(typ.Key()), i.TypeName(typ.Elem()))
 	case *types.Named:
-		if pkgName, ok := i[typ.Obj().Pkg().Path()]; ok {
-			return fmt.Sprintf("%v.%v", pkgName, typ.Obj().Name())
+		if pkg := typ.Obj().Pkg(); pkg != nil {
+			if pkgName, ok := i[pkg.Path()]; ok {
+				return fmt.Sprintf("%v.%v%v", pkgName, typ.Obj().Name(), i.TypeArgs(typ))
+			}
 		}
-		return typ.Obj().Name()
+		return typ.Obj().Name() + i.TypeArgs(typ)
 	default:
 		return t.String()
 	}
 }
 
+// TypeArgs returns the type arguments of an instantiated generic type in the form of "[A, B]".
+// It returns an empty string for a non-generic type.
+func (i ImportNames) TypeArgs(t *types.Named) string {
+	args := t.TypeArgs()
+	if args.Len() == 0 {
+		return ""
+	}
+
+	names := make([]string, args.Len())
+	for j := 0; j < args.Len(); j++ {
+		names[j] = i.TypeName(args.At(j))
+	}
+	return "[" + strings.Join(names, ", ") + "]"
+}
+
 // IsExternal returns true if the given type is defined in a different package than
 // the conversion setup file.
 func (i ImportNames) IsExternal(t types.Type) bool {
@@ -89,3 +89,32 @@ func TestImportNames_TypeName(t *testing.T) {
 	assert.True(t, ok)
 	assert.NotEmpty(t, path)
 }
+
+func TestImportNames_TypeNameGeneric(t *testing.T) {
+	source := `
+		package main
+
+		import (
+			"time"
+		)
+
+		type Page[T any] struct {
+			Items []T
+		}
+
+		type Pair[K comparable, V any] struct {
+			Key   K
+			Value V
+		}
+
+		var page Page[time.Time]
+		var pair *Pair[string, []Page[int]]
+		var index map[string]Page[time.Time]
+	`
+	file, _, pkg := loadSrc(t, source)
+	imports := util.NewImportNames(file.Imports)
+
+	assert.Equal(t, "Page[time.Time]", imports.TypeName(pkg.Scope().Lookup("page").Type()))
+	assert.Equal(t, "*Pair[string, []Page[int]]", imports.TypeName(pkg.Scope().Lookup("pair").Type()))
+	assert.Equal(t, "map[string]Page[time.Time]", imports.TypeName(pkg.Scope().Lookup("index").Type()))
+}
@@ -0,0 +1,18 @@
+package util_test
+
+import (
+	"testing"
+
+	"github.com/reedom/convergen/pkg/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEditDistance(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 0, util.EditDistance("Name", "Name"))
+	assert.Equal(t, 1, util.EditDistance("Name", "Names"))
+	assert.Equal(t, 2, util.EditDistance("Name", "Nmae"))
+	assert.Equal(t, 3, util.EditDistance("", "abc"))
+	assert.Equal(t, 3, util.EditDistance("kitten", "sitting"))
+}
@@ -1,12 +1,17 @@
 package util
 
 import (
+	"fmt"
 	"go/ast"
+	"go/constant"
 	"go/types"
 	"path"
+	"reflect"
+	"sort"
 	"strings"
 
 	"golang.org/x/tools/go/ast/astutil"
+	"golang.org/x/tools/go/packages"
 )
 
 type LookupFieldOpt struct {
@@ -25,6 +30,31 @@ func IsErrorType(t types.Type) bool {
 	return t.String() == "error"
 }
 
+// IsContextType returns true if the given type is context.Context.
+func IsContextType(t types.Type) bool {
+	return isStdType(t, "context", "Context")
+}
+
+// IsTimeType returns true if the given type is time.Time.
+func IsTimeType(t types.Type) bool {
+	return isStdType(t, "time", "Time")
+}
+
+// IsDurationType returns true if the given type is time.Duration.
+func IsDurationType(t types.Type) bool {
+	return isStdType(t, "time", "Duration")
+}
+
+// isStdType returns true if the given type is the named type declared in the package of the path.
+func isStdType(t types.Type, path, name string) bool {
+	named, ok := t.(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := named.Obj()
+	return obj.Pkg() != nil && obj.Pkg().Path() == path && obj.Name() == name
+}
+
 // IsInvalidType returns true if the given type is an invalid type.
 func IsInvalidType(t types.Type) bool {
 	if typ, ok := DerefPtr(t).Underlying().(*types.Basic); ok {
@@ -39,6 +69,18 @@ func IsSliceType(t types.Type) bool {
 	return ok
 }
 
+// MapType returns the map type underlying t, or nil if t is not a map.
+func MapType(t types.Type) *types.Map {
+	m, _ := t.Underlying().(*types.Map)
+	return m
+}
+
+// ArrayType returns the array type underlying t, or nil if t is not an array.
+func ArrayType(t types.Type) *types.Array {
+	a, _ := t.Underlying().(*types.Array)
+	return a
+}
+
 // IsBasicType returns true if the given type is a basic type.
 func IsBasicType(t types.Type) bool {
 	_, ok := t.(*types.Basic)
@@ -259,6 +301,73 @@ func IterateFields(t types.Type, cb func(*types.Var) (done bool)) {
 	}
 }
 
+// LookupFieldTag returns the struct tag value associated with key for the given field of the struct type t.
+// The tag options followed by a comma, such as ",omitempty", are trimmed.
+func LookupFieldTag(t types.Type, field *types.Var, key string) (value string, ok bool) {
+	value, ok = LookupRawFieldTag(t, field, key)
+	if !ok {
+		return
+	}
+	if idx := strings.Index(value, ","); 0 <= idx {
+		value = value[:idx]
+	}
+	return value, value != "" && value != "-"
+}
+
+// LookupRawFieldTag returns the struct tag value associated with key for the given field of the struct type t
+// as it is.
+func LookupRawFieldTag(t types.Type, field *types.Var, key string) (value string, ok bool) {
+	strct, isStruct := DerefPtr(t).Underlying().(*types.Struct)
+	if !isStruct {
+		return
+	}
+
+	for i := 0; i < strct.NumFields(); i++ {
+		if strct.Field(i) == field {
+			return reflect.StructTag(strct.Tag(i)).Lookup(key)
+		}
+	}
+	return
+}
+
+// EnumConsts enumerates the constants of targetType declared in pkg in the order of declaration.
+// The constants must be integers.
+func EnumConsts(pkg *packages.Package, targetType types.Type) ([]*types.Const, error) {
+	// 2. 遍历包中所有定义的对象，筛选同类型常量
+	result := []*types.Const{}
+
+	for _, obj := range pkg.TypesInfo.Defs {
+		if obj == nil {
+			continue // 跳过未定义的标识符
+		}
+
+		// 检查是否为常量
+		c, ok := obj.(*types.Const)
+		if !ok {
+			continue
+		}
+
+		// 检查类型是否与目标常量一致
+		if !types.Identical(c.Type(), targetType) {
+			continue
+		}
+
+		// 3. 提取常量值（假设为 int 类型，其他类型需适配）
+		val := c.Val()
+		if val.Kind() != constant.Int {
+			return nil, fmt.Errorf("const %s is not an integer", c.Name())
+		}
+
+		result = append(result, c)
+	}
+
+	// TypesInfo.Defs is a map, so that the order needs to be restored.
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Pos() < result[j].Pos()
+	})
+	return result, nil
+}
+
 // GetMethodReturnTypes returns the return types of the given method.
 func GetMethodReturnTypes(m *types.Func) (*types.Tuple, bool) {
 	sig := m.Type().(*types.Signature)
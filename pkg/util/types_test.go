@@ -53,6 +53,47 @@ var err2 ErrFoo
 	assert.False(t, util.IsErrorType(obj.Type()))
 }
 
+func TestIsContextType(t *testing.T) {
+	t.Parallel()
+	src := `
+package main
+
+import "context"
+
+var ctx context.Context
+type Context interface{}
+var ctx2 Context
+`
+	_, _, pkg := loadSrc(t, src)
+
+	obj := pkg.Scope().Lookup("ctx")
+	assert.True(t, util.IsContextType(obj.Type()))
+
+	obj = pkg.Scope().Lookup("ctx2")
+	assert.False(t, util.IsContextType(obj.Type()))
+}
+
+func TestIsTimeType(t *testing.T) {
+	t.Parallel()
+	src := `
+package main
+
+import "time"
+
+var at time.Time
+var d time.Duration
+type Time struct{}
+var at2 Time
+`
+	_, _, pkg := loadSrc(t, src)
+
+	assert.True(t, util.IsTimeType(pkg.Scope().Lookup("at").Type()))
+	assert.False(t, util.IsTimeType(pkg.Scope().Lookup("at2").Type()))
+	assert.False(t, util.IsTimeType(pkg.Scope().Lookup("d").Type()))
+	assert.True(t, util.IsDurationType(pkg.Scope().Lookup("d").Type()))
+	assert.False(t, util.IsDurationType(pkg.Scope().Lookup("at").Type()))
+}
+
 func TestIsInvalidType(t *testing.T) {
 	t.Parallel()
 	src := `
@@ -82,6 +123,48 @@ var MyVar int
 	assert.False(t, util.IsSliceType(obj.Type()))
 }
 
+func TestMapType(t *testing.T) {
+	t.Parallel()
+	src := `
+package custom
+
+type Tags map[string]string
+var MyMap map[string]int
+var MyTags Tags
+var MyVar int
+`
+	_, _, pkg := loadSrc(t, src)
+
+	obj := pkg.Scope().Lookup("MyMap")
+	require.NotNil(t, util.MapType(obj.Type()))
+	assert.Equal(t, "int", util.MapType(obj.Type()).Elem().String())
+	obj = pkg.Scope().Lookup("MyTags")
+	assert.NotNil(t, util.MapType(obj.Type()))
+	obj = pkg.Scope().Lookup("MyVar")
+	assert.Nil(t, util.MapType(obj.Type()))
+}
+
+func TestArrayType(t *testing.T) {
+	t.Parallel()
+	src := `
+package custom
+
+type UUID [16]byte
+var MyArray [3]float64
+var MyUUID UUID
+var MySlice []float64
+`
+	_, _, pkg := loadSrc(t, src)
+
+	obj := pkg.Scope().Lookup("MyArray")
+	require.NotNil(t, util.ArrayType(obj.Type()))
+	assert.Equal(t, int64(3), util.ArrayType(obj.Type()).Len())
+	obj = pkg.Scope().Lookup("MyUUID")
+	assert.NotNil(t, util.ArrayType(obj.Type()))
+	obj = pkg.Scope().Lookup("MySlice")
+	assert.Nil(t, util.ArrayType(obj.Type()))
+}
+
 func TestIsBasicType(t *testing.T) {
 	t.Parallel()
 	src := `
@@ -440,6 +523,40 @@ type S struct{
 	assert.Equal(t, "Foo", f.Name())
 }
 
+func TestLookupFieldTag(t *testing.T) {
+	t.Parallel()
+	// Define the source code to test.
+	source := `
+package main
+
+type S struct{
+ 	Foo int    ` + "`json:\"foo,omitempty\" db:\"foo_col\"`" + `
+ 	Bar string ` + "`json:\"-\"`" + `
+ 	Baz string
+}
+`
+	_, _, pkg := loadSrc(t, source)
+
+	obj := pkg.Scope().Lookup("S")
+	foo := util.FindField(obj.Type(), "Foo", true)
+	bar := util.FindField(obj.Type(), "Bar", true)
+	baz := util.FindField(obj.Type(), "Baz", true)
+
+	v, ok := util.LookupFieldTag(obj.Type(), foo, "json")
+	assert.True(t, ok)
+	assert.Equal(t, "foo", v)
+
+	v, ok = util.LookupFieldTag(types.NewPointer(obj.Type()), foo, "db")
+	assert.True(t, ok)
+	assert.Equal(t, "foo_col", v)
+
+	_, ok = util.LookupFieldTag(obj.Type(), bar, "json")
+	assert.False(t, ok)
+
+	_, ok = util.LookupFieldTag(obj.Type(), baz, "json")
+	assert.False(t, ok)
+}
+
 func TestGetMethodReturnTypes(t *testing.T) {
 	t.Parallel()
 	// Define the source code to test.
@@ -0,0 +1,23 @@
+//go:build convergen
+
+package ambiguousarg
+
+type Order struct {
+	ID     int64
+	Status string
+}
+
+type OrderResponse struct {
+	ID     int64
+	Status string
+}
+
+//go:generate go run github.com/reedom/convergen
+type Convergen interface {
+	// :conv Localize Status
+	ToResponse(order *Order, lang string, region string) *OrderResponse
+}
+
+func Localize(status string, locale string) string {
+	return locale + ":" + status
+}
@@ -0,0 +1,103 @@
+// Code generated by github.com/reedom/convergen
+// DO NOT EDIT.
+
+package array
+
+import (
+	"fmt"
+	"strconv"
+)
+
+type UUID [16]byte
+
+type Point struct {
+	ID     UUID
+	Vector [3]float64
+	Tags   [2]string
+	Codes  []int
+}
+
+type PointModel struct {
+	ID     [16]byte
+	Vector [3]float32
+	Tags   []string
+	Codes  [4]int64
+}
+
+type Reading struct {
+	Samples []string
+}
+
+type ReadingModel struct {
+	Samples [3]int
+}
+
+func PointFromModel(src *PointModel) (dst *Point) {
+	if src == nil {
+		return
+	}
+
+	dst = &Point{}
+	dst.ID = src.ID
+	for i, e := range src.Vector {
+		dst.Vector[i] = float64(e)
+	}
+	// no match: dst.Tags
+	dst.Codes = make([]int, len(src.Codes))
+	for i, e := range src.Codes {
+		dst.Codes[i] = int(e)
+	}
+
+	return
+}
+
+func PointToModel(src *Point) (dst *PointModel, err error) {
+	if src == nil {
+		return
+	}
+
+	dst = &PointModel{}
+	dst.ID = src.ID
+	for i, e := range src.Vector {
+		dst.Vector[i] = float32(e)
+	}
+	dst.Tags = make([]string, len(src.Tags))
+	for i, e := range src.Tags {
+		dst.Tags[i] = e
+	}
+	if len(src.Codes) != len(dst.Codes) {
+		err = fmt.Errorf("src.Codes has %d elements, but dst.Codes has %d", len(src.Codes), len(dst.Codes))
+	} else {
+		for i, e := range src.Codes {
+			dst.Codes[i] = int64(e)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+func ReadingToModel(src *Reading) (dst *ReadingModel, err error) {
+	if src == nil {
+		return
+	}
+
+	dst = &ReadingModel{}
+	if len(src.Samples) != len(dst.Samples) {
+		err = fmt.Errorf("src.Samples has %d elements, but dst.Samples has %d", len(src.Samples), len(dst.Samples))
+	} else {
+		for i, e := range src.Samples {
+			dst.Samples[i], err = strconv.Atoi(e)
+			if err != nil {
+				return
+			}
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return
+}
@@ -0,0 +1,39 @@
+//go:build convergen
+
+package array
+
+import "strconv"
+
+type UUID [16]byte
+
+type Point struct {
+	ID     UUID
+	Vector [3]float64
+	Tags   [2]string
+	Codes  []int
+}
+
+type PointModel struct {
+	ID     [16]byte
+	Vector [3]float32
+	Tags   []string
+	Codes  [4]int64
+}
+
+type Reading struct {
+	Samples []string
+}
+
+type ReadingModel struct {
+	Samples [3]int
+}
+
+//go:generate go run github.com/reedom/convergen
+type Convergen interface {
+	// :typecast
+	PointToModel(*Point) (*PointModel, error)
+	// :typecast
+	PointFromModel(*PointModel) *Point
+	// :conv:type strconv.Atoi string int
+	ReadingToModel(*Reading) (*ReadingModel, error)
+}
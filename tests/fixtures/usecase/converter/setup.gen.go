@@ -9,6 +9,10 @@ import (
 )
 
 func DomainToModel(src *domain.Pet) (dst *model.Pet) {
+	if src == nil {
+		return
+	}
+
 	dst = &model.Pet{}
 	// no match: dst.ID
 	dst.Category = fromDomainCategory(src.Category)
@@ -20,6 +24,10 @@ func DomainToModel(src *domain.Pet) (dst *model.Pet) {
 }
 
 func ModelToDomain(src *model.Pet) (dst *domain.Pet, err error) {
+	if src == nil {
+		return
+	}
+
 	dst = &domain.Pet{}
 	// no match: dst.ID
 	dst.Category = toDomainCategory(src.Category)
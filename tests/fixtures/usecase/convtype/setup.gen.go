@@ -0,0 +1,122 @@
+// Code generated by github.com/reedom/convergen
+// DO NOT EDIT.
+
+package convtype
+
+import (
+	"time"
+)
+
+type Event struct {
+	Name      string
+	Created   time.Time
+	Schedule  Schedule
+	Reminders []time.Time
+}
+
+type Schedule struct {
+	Start time.Time
+	End   time.Time
+}
+
+type EventRecord struct {
+	Name      string
+	Created   int64
+	Schedule  ScheduleRecord
+	Reminders []int64
+}
+
+type ScheduleRecord struct {
+	Start int64
+	End   int64
+}
+
+func FromRecord(src *EventRecord) (dst *Event) {
+	if src == nil {
+		return
+	}
+
+	dst = &Event{}
+	dst.Name = src.Name
+	dst.Created = MillisToTime(src.Created)
+	dst.Schedule = scheduleRecordToSchedule(src.Schedule)
+	if src.Reminders != nil {
+		dst.Reminders = make([]time.Time, len(src.Reminders))
+		for i, e := range src.Reminders {
+			dst.Reminders[i] = MillisToTime(e)
+		}
+	}
+
+	return
+}
+
+func ToRecord(src *Event) (dst *EventRecord) {
+	if src == nil {
+		return
+	}
+
+	dst = &EventRecord{}
+	dst.Name = src.Name
+	dst.Created = TimeToMillis(src.Created)
+	dst.Schedule = scheduleToScheduleRecord(src.Schedule)
+	if src.Reminders != nil {
+		dst.Reminders = make([]int64, len(src.Reminders))
+		for i, e := range src.Reminders {
+			dst.Reminders[i] = TimeToMillis(e)
+		}
+	}
+
+	return
+}
+
+func ToRecordInSeconds(src *Event) (dst *EventRecord) {
+	if src == nil {
+		return
+	}
+
+	dst = &EventRecord{}
+	dst.Name = src.Name
+	dst.Created = TimeToUnix(src.Created)
+	dst.Schedule = scheduleToScheduleRecord2(src.Schedule)
+	if src.Reminders != nil {
+		dst.Reminders = make([]int64, len(src.Reminders))
+		for i, e := range src.Reminders {
+			dst.Reminders[i] = TimeToUnix(e)
+		}
+	}
+
+	return
+}
+
+func scheduleRecordToSchedule(src ScheduleRecord) (dst Schedule) {
+	dst.Start = MillisToTime(src.Start)
+	dst.End = MillisToTime(src.End)
+
+	return
+}
+
+func scheduleToScheduleRecord(src Schedule) (dst ScheduleRecord) {
+	dst.Start = TimeToMillis(src.Start)
+	dst.End = TimeToMillis(src.End)
+
+	return
+}
+
+func scheduleToScheduleRecord2(src Schedule) (dst ScheduleRecord) {
+	dst.Start = TimeToUnix(src.Start)
+	dst.End = TimeToUnix(src.End)
+
+	return
+}
+
+func TimeToMillis(t time.Time) int64 {
+	return t.UnixMilli()
+}
+
+func TimeToUnix(t time.Time) int64 {
+	return t.Unix()
+}
+
+func MillisToTime(ms int64) time.Time {
+	return time.UnixMilli(ms)
+}
@@ -0,0 +1,53 @@
+//go:build convergen
+
+package convtype
+
+import (
+	"time"
+)
+
+type Event struct {
+	Name      string
+	Created   time.Time
+	Schedule  Schedule
+	Reminders []time.Time
+}
+
+type Schedule struct {
+	Start time.Time
+	End   time.Time
+}
+
+type EventRecord struct {
+	Name      string
+	Created   int64
+	Schedule  ScheduleRecord
+	Reminders []int64
+}
+
+type ScheduleRecord struct {
+	Start int64
+	End   int64
+}
+
+//go:generate go run github.com/reedom/convergen
+// :conv:type TimeToMillis time.Time
+type Convergen interface {
+	ToRecord(*Event) *EventRecord
+	// :conv:type TimeToUnix time.Time int64
+	ToRecordInSeconds(*Event) *EventRecord
+	// :conv:type MillisToTime int64 time.Time
+	FromRecord(*EventRecord) *Event
+}
+
+func TimeToMillis(t time.Time) int64 {
+	return t.UnixMilli()
+}
+
+func TimeToUnix(t time.Time) int64 {
+	return t.Unix()
+}
+
+func MillisToTime(ms int64) time.Time {
+	return time.UnixMilli(ms)
+}
@@ -0,0 +1,88 @@
+// Code generated by github.com/reedom/convergen
+// DO NOT EDIT.
+
+package convwith
+
+import (
+	"errors"
+)
+
+type User struct {
+	First string
+	Last  string
+	Age   int
+}
+
+type UserResponse struct {
+	FullName string
+	Age      int
+	Label    string
+}
+
+type Account struct {
+	Email string
+}
+
+type MergedResponse struct {
+	FullName string
+	Email    string
+}
+
+func Merge(u *User, a *Account) (dst *MergedResponse) {
+	if u == nil && a == nil {
+		return
+	}
+
+	dst = &MergedResponse{}
+	if u != nil {
+		dst.FullName = FullName(u)
+	}
+	if a != nil {
+		dst.Email = a.Email
+	}
+
+	return
+}
+
+func ToResponse(src *User) (dst *UserResponse) {
+	if src == nil {
+		return
+	}
+
+	dst = &UserResponse{}
+	dst.FullName = FullName(src)
+	dst.Age = src.Age
+	// skip: dst.Label
+
+	return
+}
+
+func ToResponseWithLabel(src *User) (dst *UserResponse, err error) {
+	if src == nil {
+		return
+	}
+
+	dst = &UserResponse{}
+	dst.FullName = FullName(src)
+	dst.Age = src.Age
+	dst.Label, err = Describe(*src)
+	if err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+func FullName(u *User) string {
+	return u.First + " " + u.Last
+}
+
+func Describe(u User) (string, error) {
+	if u.Age < 0 {
+		return "", errors.New("invalid age")
+	}
+	if u.Age < 20 {
+		return "junior", nil
+	}
+	return "senior", nil
+}
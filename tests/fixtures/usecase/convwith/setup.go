@@ -0,0 +1,54 @@
+//go:build convergen
+
+package convwith
+
+import (
+	"errors"
+)
+
+type User struct {
+	First string
+	Last  string
+	Age   int
+}
+
+type UserResponse struct {
+	FullName string
+	Age      int
+	Label    string
+}
+
+type Account struct {
+	Email string
+}
+
+type MergedResponse struct {
+	FullName string
+	Email    string
+}
+
+//go:generate go run github.com/reedom/convergen
+type Convergen interface {
+	// :conv:with FullName FullName
+	// :skip Label
+	ToResponse(*User) *UserResponse
+	// :conv:with FullName FullName
+	// :conv:with Describe Label
+	ToResponseWithLabel(*User) (*UserResponse, error)
+	// :conv:with FullName FullName
+	Merge(u *User, a *Account) *MergedResponse
+}
+
+func FullName(u *User) string {
+	return u.First + " " + u.Last
+}
+
+func Describe(u User) (string, error) {
+	if u.Age < 0 {
+		return "", errors.New("invalid age")
+	}
+	if u.Age < 20 {
+		return "junior", nil
+	}
+	return "senior", nil
+}
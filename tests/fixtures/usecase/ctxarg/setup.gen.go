@@ -0,0 +1,107 @@
+// Code generated by github.com/reedom/convergen
+// DO NOT EDIT.
+
+package ctxarg
+
+import (
+	"context"
+	"errors"
+	"strconv"
+)
+
+type Order struct {
+	ID     int64
+	Status string
+	Tags   []int
+	Note   string
+}
+
+type OrderResponse struct {
+	ID     int64
+	Status string
+	Tags   []string
+	Note   string
+	Locale string
+}
+
+func ToRegionalResponse(ctx context.Context, order *Order, region string, locale string) (dst *OrderResponse, err error) {
+	if order == nil {
+		return
+	}
+
+	dst = &OrderResponse{}
+	dst.ID = order.ID
+	dst.Status = Localize(ctx, order.Status, locale)
+	if order.Tags != nil {
+		dst.Tags = make([]string, len(order.Tags))
+		for i, e := range order.Tags {
+			dst.Tags[i] = Translate(ctx, e)
+		}
+	}
+	dst.Note, err = ValidateNote(order.Note)
+	if err != nil {
+		return nil, err
+	}
+	// skip: dst.Locale
+	SetLocale(dst, order, locale)
+
+	return
+}
+
+func ToResponse(ctx context.Context, order *Order, locale string) (dst *OrderResponse, err error) {
+	dst = &OrderResponse{}
+	err = CheckAccess(ctx, dst, order)
+	if err != nil {
+		return
+	}
+
+	if order == nil {
+		return
+	}
+
+	dst.ID = order.ID
+	dst.Status = Localize(ctx, order.Status, locale)
+	if order.Tags != nil {
+		dst.Tags = make([]string, len(order.Tags))
+		for i, e := range order.Tags {
+			dst.Tags[i] = Translate(ctx, e)
+		}
+	}
+	dst.Note, err = ValidateNote(order.Note)
+	if err != nil {
+		return nil, err
+	}
+	// skip: dst.Locale
+	SetLocale(dst, order, locale)
+
+	return
+}
+
+func Localize(ctx context.Context, status string, locale string) string {
+	if locale == "ja" {
+		return "[" + status + "]"
+	}
+	return status
+}
+
+func Translate(ctx context.Context, tag int) string {
+	return "#" + strconv.Itoa(tag)
+}
+
+func ValidateNote(note string) (string, error) {
+	if len(note) > 100 {
+		return "", errors.New("too long")
+	}
+	return note, nil
+}
+
+func CheckAccess(ctx context.Context, dst *OrderResponse, src *Order) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return nil
+}
+
+func SetLocale(dst *OrderResponse, src *Order, locale string) {
+	dst.Locale = locale
+}
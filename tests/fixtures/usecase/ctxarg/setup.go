@@ -0,0 +1,70 @@
+//go:build convergen
+
+package ctxarg
+
+import (
+	"context"
+	"errors"
+	"strconv"
+)
+
+type Order struct {
+	ID     int64
+	Status string
+	Tags   []int
+	Note   string
+}
+
+type OrderResponse struct {
+	ID     int64
+	Status string
+	Tags   []string
+	Note   string
+	Locale string
+}
+
+//go:generate go run github.com/reedom/convergen
+type Convergen interface {
+	// :conv Localize Status
+	// :conv Translate Tags[]
+	// :conv ValidateNote Note
+	// :preprocess CheckAccess
+	// :skip Locale
+	// :postprocess SetLocale
+	ToResponse(ctx context.Context, order *Order, locale string) (*OrderResponse, error)
+	// :conv Localize Status
+	// :conv Translate Tags[]
+	// :conv ValidateNote Note
+	// :skip Locale
+	// :postprocess SetLocale
+	ToRegionalResponse(ctx context.Context, order *Order, region string, locale string) (*OrderResponse, error)
+}
+
+func Localize(ctx context.Context, status string, locale string) string {
+	if locale == "ja" {
+		return "[" + status + "]"
+	}
+	return status
+}
+
+func Translate(ctx context.Context, tag int) string {
+	return "#" + strconv.Itoa(tag)
+}
+
+func ValidateNote(note string) (string, error) {
+	if len(note) > 100 {
+		return "", errors.New("too long")
+	}
+	return note, nil
+}
+
+func CheckAccess(ctx context.Context, dst *OrderResponse, src *Order) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return nil
+}
+
+func SetLocale(dst *OrderResponse, src *Order, locale string) {
+	dst.Locale = locale
+}
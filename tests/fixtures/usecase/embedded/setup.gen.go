@@ -9,26 +9,58 @@ import (
 )
 
 func DomainToModel(s *domain.Concrete) (d *model.Concrete) {
+	if s == nil {
+		return
+	}
+
 	d = &model.Concrete{}
 	d.Base.ID = s.Base.ID
 	d.Base.Created = s.Base.Created()
 	d.Name = s.Name
-	d.NestedData.Base.ID = s.NestedData.Base.ID
-	d.NestedData.Base.Created = s.NestedData.Base.Created()
-	d.NestedData.NestedDataSub.Base.ID = s.NestedData.NestedDataSub.Base.ID
-	d.NestedData.NestedDataSub.Base.Created = s.NestedData.NestedDataSub.Base.Created()
-	d.NestedData.NestedDataSub.ID = s.NestedData.NestedDataSub.ID
+	d.NestedData = domainNestToModelNest(s.NestedData)
 
 	return
 }
 
 func ModelToDomain(src *model.Concrete) (dst *domain.Concrete, err error) {
+	if src == nil {
+		return
+	}
+
 	dst = &domain.Concrete{}
 	dst.Base.ID = src.Base.ID
 	dst.Name = src.Name
-	dst.NestedData.Base.ID = src.NestedData.Base.ID
-	dst.NestedData.NestedDataSub.Base.ID = src.NestedData.NestedDataSub.Base.ID
-	dst.NestedData.NestedDataSub.ID = src.NestedData.NestedDataSub.ID
+	dst.NestedData = modelNestToDomainNest(src.NestedData)
+
+	return
+}
+
+func domainNestToModelNest(src domain.Nest) (dst model.Nest) {
+	dst.Base.ID = src.Base.ID
+	dst.Base.Created = src.Base.Created()
+	dst.NestedDataSub = domainNestSubToModelNestSub(src.NestedDataSub)
+
+	return
+}
+
+func domainNestSubToModelNestSub(src domain.NestSub) (dst model.NestSub) {
+	dst.Base.ID = src.Base.ID
+	dst.Base.Created = src.Base.Created()
+	dst.ID = src.ID
+
+	return
+}
+
+func modelNestToDomainNest(src model.Nest) (dst domain.Nest) {
+	dst.Base.ID = src.Base.ID
+	dst.NestedDataSub = modelNestSubToDomainNestSub(src.NestedDataSub)
+
+	return
+}
+
+func modelNestSubToDomainNestSub(src model.NestSub) (dst domain.NestSub) {
+	dst.Base.ID = src.Base.ID
+	dst.ID = src.ID
 
 	return
 }
@@ -0,0 +1,32 @@
+//go:build convergen
+
+package enumextra
+
+type Status int
+
+const (
+	StatusUnspecified Status = iota
+	StatusActive
+	StatusArchived
+)
+
+type PbStatus int32
+
+const (
+	PbStatus_STATUS_UNSPECIFIED PbStatus = 0
+	PbStatus_STATUS_ACTIVE      PbStatus = 1
+)
+
+type User struct {
+	Status Status
+}
+
+type PbUser struct {
+	Status PbStatus
+}
+
+//go:generate go run github.com/reedom/convergen
+type Convergen interface {
+	// :enum PbStatus_STATUS Status
+	UserToPb(*User) *PbUser
+}
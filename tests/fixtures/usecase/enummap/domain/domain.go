@@ -0,0 +1,16 @@
+package domain
+
+type Status int
+
+const (
+	StatusUnspecified Status = iota
+	StatusActive
+	StatusSuspended
+)
+
+type Priority int
+
+const (
+	PriorityLow Priority = iota
+	PriorityHigh
+)
@@ -0,0 +1,24 @@
+package pb
+
+type Status int32
+
+const (
+	Status_STATUS_UNSPECIFIED Status = 0
+	Status_STATUS_ACTIVE      Status = 1
+	Status_STATUS_SUSPENDED   Status = 2
+	Status_STATUS_DELETED     Status = 3
+)
+
+type Priority int32
+
+const (
+	Priority_PRIORITY_LOW  Priority = 0
+	Priority_PRIORITY_HIGH Priority = 1
+)
+
+type User struct {
+	Id       int64
+	Status   Status
+	Priority Priority
+	History  []Status
+}
@@ -0,0 +1,59 @@
+// Code generated by github.com/reedom/convergen
+// DO NOT EDIT.
+
+package enummap
+
+import (
+	"github.com/reedom/convergen/tests/fixtures/usecase/enummap/domain"
+	"github.com/reedom/convergen/tests/fixtures/usecase/enummap/pb"
+)
+
+type User struct {
+	Id       int64
+	Status   domain.Status
+	Priority domain.Priority
+	History  []domain.Status
+}
+
+func UserToPb(src *User) (dst *pb.User) {
+	if src == nil {
+		return
+	}
+
+	dst = &pb.User{}
+	dst.Id = src.Id
+	dst.Status = domainStatusToPbStatus(src.Status)
+	dst.Priority = domainPriorityToPbPriority(src.Priority)
+	if src.History != nil {
+		dst.History = make([]pb.Status, len(src.History))
+		for i, e := range src.History {
+			dst.History[i] = domainStatusToPbStatus(e)
+		}
+	}
+
+	return
+}
+
+func domainStatusToPbStatus(src domain.Status) (dst pb.Status) {
+	switch src {
+	case domain.StatusUnspecified:
+		dst = pb.Status_STATUS_UNSPECIFIED
+	case domain.StatusActive:
+		dst = pb.Status_STATUS_ACTIVE
+	case domain.StatusSuspended:
+		dst = pb.Status_STATUS_SUSPENDED
+	}
+
+	return
+}
+
+func domainPriorityToPbPriority(src domain.Priority) (dst pb.Priority) {
+	switch src {
+	case domain.PriorityLow:
+		dst = pb.Priority_PRIORITY_LOW
+	case domain.PriorityHigh:
+		dst = pb.Priority_PRIORITY_HIGH
+	}
+
+	return
+}
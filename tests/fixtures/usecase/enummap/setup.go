@@ -0,0 +1,21 @@
+//go:build convergen
+
+package enummap
+
+import (
+	"github.com/reedom/convergen/tests/fixtures/usecase/enummap/domain"
+	"github.com/reedom/convergen/tests/fixtures/usecase/enummap/pb"
+)
+
+type User struct {
+	Id       int64
+	Status   domain.Status
+	Priority domain.Priority
+	History  []domain.Status
+}
+
+//go:generate go run github.com/reedom/convergen
+type Convergen interface {
+	// :enum Status_STATUS Priority_PRIORITY Status Priority
+	UserToPb(*User) *pb.User
+}
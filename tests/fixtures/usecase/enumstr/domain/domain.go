@@ -0,0 +1,30 @@
+package domain
+
+type Status int
+
+const (
+	StatusActive Status = iota + 1
+	StatusSuspended
+	StatusDeleted
+
+	StatusDefault = StatusActive
+)
+
+type Role int8
+
+const (
+	RoleGuest Role = iota
+	RoleMember
+	RoleAdmin
+)
+
+func (r Role) Label() string {
+	switch r {
+	case RoleMember:
+		return "member"
+	case RoleAdmin:
+		return "admin"
+	default:
+		return "guest"
+	}
+}
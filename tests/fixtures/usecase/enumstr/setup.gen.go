@@ -0,0 +1,148 @@
+// Code generated by github.com/reedom/convergen
+// DO NOT EDIT.
+
+package enumstr
+
+import (
+	"fmt"
+
+	"github.com/reedom/convergen/tests/fixtures/usecase/enumstr/domain"
+)
+
+type User struct {
+	ID         int
+	Status     domain.Status
+	PrevStatus *domain.Status
+	Role       domain.Role
+	History    []domain.Status
+	Grants     []domain.Role
+}
+
+type UserView struct {
+	ID         int
+	Status     string
+	PrevStatus *string
+	Role       string
+	History    []string
+	Grants     []string
+}
+
+func UserFromView(src *UserView) (dst *User, err error) {
+	if src == nil {
+		return
+	}
+
+	dst = &User{}
+	dst.ID = src.ID
+	dst.Status, err = stringToDomainStatus(src.Status)
+	if err != nil {
+		return nil, err
+	}
+	if src.PrevStatus != nil {
+		dst.PrevStatus = new(domain.Status)
+		*dst.PrevStatus, err = stringToDomainStatus(*src.PrevStatus)
+	}
+	if err != nil {
+		return nil, err
+	}
+	dst.Role, err = stringToDomainRole(src.Role)
+	if err != nil {
+		return nil, err
+	}
+	if src.History != nil {
+		dst.History = make([]domain.Status, len(src.History))
+		for i, e := range src.History {
+			dst.History[i], err = stringToDomainStatus(e)
+			if err != nil {
+				return
+			}
+		}
+	}
+	if src.Grants != nil {
+		dst.Grants = make([]domain.Role, len(src.Grants))
+		for i, e := range src.Grants {
+			dst.Grants[i], err = stringToDomainRole(e)
+			if err != nil {
+				return
+			}
+		}
+	}
+
+	return
+}
+
+func UserToView(src *User) (dst *UserView) {
+	if src == nil {
+		return
+	}
+
+	dst = &UserView{}
+	dst.ID = src.ID
+	dst.Status = domainStatusToString(src.Status)
+	if src.PrevStatus != nil {
+		dst.PrevStatus = new(string)
+		*dst.PrevStatus = domainStatusToString(*src.PrevStatus)
+	}
+	dst.Role = src.Role.Label()
+	if src.History != nil {
+		dst.History = make([]string, len(src.History))
+		for i, e := range src.History {
+			dst.History[i] = domainStatusToString(e)
+		}
+	}
+	if src.Grants != nil {
+		dst.Grants = make([]string, len(src.Grants))
+		for i, e := range src.Grants {
+			dst.Grants[i] = domain.Role.Label(e)
+		}
+	}
+
+	return
+}
+
+func stringToDomainStatus(src string) (dst domain.Status, err error) {
+	switch src {
+	case "StatusActive":
+		dst = domain.StatusActive
+	case "StatusSuspended":
+		dst = domain.StatusSuspended
+	case "StatusDeleted":
+		dst = domain.StatusDeleted
+	case "StatusDefault":
+		dst = domain.StatusDefault
+	default:
+		err = fmt.Errorf("unknown domain.Status %q", src)
+	}
+
+	return
+}
+
+func stringToDomainRole(src string) (dst domain.Role, err error) {
+	switch src {
+	case domain.RoleGuest.Label():
+		dst = domain.RoleGuest
+	case domain.RoleMember.Label():
+		dst = domain.RoleMember
+	case domain.RoleAdmin.Label():
+		dst = domain.RoleAdmin
+	default:
+		err = fmt.Errorf("unknown domain.Role %q", src)
+	}
+
+	return
+}
+
+func domainStatusToString(src domain.Status) (dst string) {
+	switch src {
+	case domain.StatusActive:
+		dst = "StatusActive"
+	case domain.StatusSuspended:
+		dst = "StatusSuspended"
+	case domain.StatusDeleted:
+		dst = "StatusDeleted"
+	default:
+		dst = fmt.Sprintf("domain.Status(%d)", src)
+	}
+
+	return
+}
@@ -0,0 +1,35 @@
+//go:build convergen
+
+package enumstr
+
+import (
+	"github.com/reedom/convergen/tests/fixtures/usecase/enumstr/domain"
+)
+
+type User struct {
+	ID         int
+	Status     domain.Status
+	PrevStatus *domain.Status
+	Role       domain.Role
+	History    []domain.Status
+	Grants     []domain.Role
+}
+
+type UserView struct {
+	ID         int
+	Status     string
+	PrevStatus *string
+	Role       string
+	History    []string
+	Grants     []string
+}
+
+//go:generate go run github.com/reedom/convergen
+type Convergen interface {
+	// :enum:string domain.Status
+	// :enum:string domain.Role Label
+	UserToView(*User) *UserView
+	// :enum:string domain.Status
+	// :enum:string domain.Role Label
+	UserFromView(*UserView) (*User, error)
+}
@@ -0,0 +1,53 @@
+// Code generated by github.com/reedom/convergen
+// DO NOT EDIT.
+
+package exhaustive
+
+type Profile struct {
+	Bio string
+}
+
+type User struct {
+	ID        int
+	Name      string
+	Email     string
+	Profile   Profile
+	Password  string
+	CreatedAt int64
+	UpdatedAt int64
+}
+
+type UserModel struct {
+	ID      int
+	Name    string
+	Mail    string
+	Profile Profile
+}
+
+func UserToModel(src *User) (dst *UserModel) {
+	if src == nil {
+		return
+	}
+
+	dst = &UserModel{}
+	dst.ID = src.ID
+	dst.Name = src.Name
+	dst.Mail = src.Email
+	dst.Profile = src.Profile
+
+	return
+}
+
+func UserToModelLoose(src *User) (dst *UserModel) {
+	if src == nil {
+		return
+	}
+
+	dst = &UserModel{}
+	dst.ID = src.ID
+	dst.Name = src.Name
+	// no match: dst.Mail
+	dst.Profile = src.Profile
+
+	return
+}
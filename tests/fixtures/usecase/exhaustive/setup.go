@@ -0,0 +1,35 @@
+//go:build convergen
+
+package exhaustive
+
+type Profile struct {
+	Bio string
+}
+
+type User struct {
+	ID        int
+	Name      string
+	Email     string
+	Profile   Profile
+	Password  string
+	CreatedAt int64
+	UpdatedAt int64
+}
+
+type UserModel struct {
+	ID      int
+	Name    string
+	Mail    string
+	Profile Profile
+}
+
+//go:generate go run github.com/reedom/convergen
+// :exhaustive:src
+// :ignore:src Password
+type Convergen interface {
+	// :map Email Mail
+	// :ignore:src /At$/
+	UserToModel(*User) *UserModel
+	// :exhaustive:src:off
+	UserToModelLoose(*User) *UserModel
+}
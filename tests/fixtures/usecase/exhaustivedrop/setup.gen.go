@@ -0,0 +1,32 @@
+// Code generated by github.com/reedom/convergen
+// DO NOT EDIT.
+
+package exhaustivedrop
+
+type User struct {
+	ID    int
+	Name  string
+	Age   string
+	Email []string
+}
+
+type UserModel struct {
+	ID   int
+	Name string
+	Age  int
+	Mail string
+}
+
+func UserToModel(src *User) (dst *UserModel) {
+	if src == nil {
+		return
+	}
+
+	dst = &UserModel{}
+	dst.ID = src.ID
+	dst.Name = src.Name
+	// no match: dst.Age
+	// no match: dst.Mail
+
+	return
+}
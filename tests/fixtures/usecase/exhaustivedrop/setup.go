@@ -0,0 +1,25 @@
+//go:build convergen
+
+package exhaustivedrop
+
+type User struct {
+	ID    int
+	Name  string
+	Age   string
+	Email []string
+}
+
+type UserModel struct {
+	ID   int
+	Name string
+	Age  int
+	Mail string
+}
+
+// :exhaustive:src
+//
+//go:generate go run github.com/reedom/convergen
+type Convergen interface {
+	// :map Email Mail
+	UserToModel(*User) *UserModel
+}
@@ -0,0 +1,65 @@
+// Code generated by github.com/reedom/convergen
+// DO NOT EDIT.
+
+package fieldtag
+
+import (
+	"strconv"
+	"time"
+)
+
+type User struct {
+	ID        int
+	Name      string
+	Password  string    `convergen:"-"`
+	CreatedAt time.Time `convergen:"name=Created,conv=toMillis"`
+	Nickname  string
+}
+
+type UserModel struct {
+	ID       int
+	Name     string
+	Password string
+	Created  int64
+	Alias    string `convergen:"name=Nickname"`
+	Updated  string `convergen:"-"`
+}
+
+type UserForm struct {
+	ID       string `convergen:"conv=strconv.Itoa"`
+	Name     string
+	Password string
+}
+
+func ToForm(src *User) (dst *UserForm) {
+	if src == nil {
+		return
+	}
+
+	dst = &UserForm{}
+	dst.ID = strconv.Itoa(src.ID)
+	dst.Name = src.Name
+	// no match: dst.Password
+
+	return
+}
+
+func ToModel(src *User) (dst *UserModel) {
+	if src == nil {
+		return
+	}
+
+	dst = &UserModel{}
+	dst.ID = src.ID
+	dst.Name = src.Name
+	// no match: dst.Password
+	dst.Created = toMillis(src.CreatedAt)
+	dst.Alias = src.Nickname
+	// skip: dst.Updated
+
+	return
+}
+
+func toMillis(t time.Time) int64 {
+	return t.UnixMilli()
+}
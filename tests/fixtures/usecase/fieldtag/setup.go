@@ -0,0 +1,41 @@
+//go:build convergen
+
+package fieldtag
+
+import (
+	"strconv"
+	"time"
+)
+
+type User struct {
+	ID        int
+	Name      string
+	Password  string    `convergen:"-"`
+	CreatedAt time.Time `convergen:"name=Created,conv=toMillis"`
+	Nickname  string
+}
+
+type UserModel struct {
+	ID       int
+	Name     string
+	Password string
+	Created  int64
+	Alias    string `convergen:"name=Nickname"`
+	Updated  string `convergen:"-"`
+}
+
+type UserForm struct {
+	ID       string `convergen:"conv=strconv.Itoa"`
+	Name     string
+	Password string
+}
+
+//go:generate go run github.com/reedom/convergen
+type Convergen interface {
+	ToModel(*User) *UserModel
+	ToForm(*User) *UserForm
+}
+
+func toMillis(t time.Time) int64 {
+	return t.UnixMilli()
+}
@@ -0,0 +1,92 @@
+// Code generated by github.com/reedom/convergen
+// DO NOT EDIT.
+
+package generics
+
+import (
+	"github.com/reedom/convergen/tests/fixtures/data/model"
+)
+
+type Paged[T any] struct {
+	Items []T
+	Total int
+	Next  *string
+	Last  Ref[T]
+}
+
+type Ref[T any] int64
+
+type Envelope[T any] struct {
+	Data    T
+	Version int
+}
+
+type User struct {
+	ID   int
+	Name string
+}
+
+type UserModel struct {
+	ID   int
+	Name string
+}
+
+type PetID int64
+
+type Pet struct {
+	ID   PetID
+	Name string
+}
+
+func EnvelopeToModel(src *Envelope[User]) (dst *Envelope[UserModel]) {
+	if src == nil {
+		return
+	}
+
+	dst = &Envelope[UserModel]{}
+	dst.Data = userToUserModel(src.Data)
+	dst.Version = src.Version
+
+	return
+}
+
+func PagedFromModel(src *Paged[model.Pet]) (dst *Paged[Pet]) {
+	if src == nil {
+		return
+	}
+
+	dst = &Paged[Pet]{}
+	dst.Items = petsFromModel(src.Items)
+	dst.Total = src.Total
+	dst.Next = src.Next
+	dst.Last = Ref[Pet](src.Last)
+
+	return
+}
+
+func PetFromModel(src *model.Pet) (dst *Pet) {
+	if src == nil {
+		return
+	}
+
+	dst = &Pet{}
+	dst.ID = PetID(src.ID)
+	dst.Name = src.Name
+
+	return
+}
+
+func userToUserModel(src User) (dst UserModel) {
+	dst.ID = src.ID
+	dst.Name = src.Name
+
+	return
+}
+
+func petsFromModel(list []model.Pet) []Pet {
+	ret := make([]Pet, len(list))
+	for i, pet := range list {
+		ret[i] = *PetFromModel(&pet)
+	}
+	return ret
+}
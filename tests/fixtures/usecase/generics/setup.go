@@ -0,0 +1,56 @@
+//go:build convergen
+
+package generics
+
+import (
+	"github.com/reedom/convergen/tests/fixtures/data/model"
+)
+
+type Paged[T any] struct {
+	Items []T
+	Total int
+	Next  *string
+	Last  Ref[T]
+}
+
+type Ref[T any] int64
+
+type Envelope[T any] struct {
+	Data    T
+	Version int
+}
+
+type User struct {
+	ID   int
+	Name string
+}
+
+type UserModel struct {
+	ID   int
+	Name string
+}
+
+type PetID int64
+
+type Pet struct {
+	ID   PetID
+	Name string
+}
+
+//go:generate go run github.com/reedom/convergen
+type Convergen interface {
+	EnvelopeToModel(*Envelope[User]) *Envelope[UserModel]
+	// :typecast
+	PetFromModel(*model.Pet) *Pet
+	// :typecast
+	// :conv petsFromModel Items
+	PagedFromModel(src *Paged[model.Pet]) (dst *Paged[Pet])
+}
+
+func petsFromModel(list []model.Pet) []Pet {
+	ret := make([]Pet, len(list))
+	for i, pet := range list {
+		ret[i] = *PetFromModel(&pet)
+	}
+	return ret
+}
@@ -10,10 +10,13 @@ import (
 
 // DomainToModel copies domain.Pet to model.Pet.
 func DomainToModel(pet *domain.Pet) (dst *model.Pet) {
+	if pet == nil {
+		return
+	}
+
 	dst = &model.Pet{}
 	dst.ID = pet.ID()
-	// no match: dst.Category.CategoryID
-	dst.Category.Name = pet.Category().Name()
+	dst.Category = domainCategoryToModelCategory(pet.Category())
 	dst.Name = pet.Name()
 	// skip: dst.PhotoUrls
 	// no match: dst.Status
@@ -23,13 +26,23 @@ func DomainToModel(pet *domain.Pet) (dst *model.Pet) {
 
 // DomainToModelNoGetter copies domain.Pet to model.Pet but not using getters.
 func DomainToModelNoGetter(pet *domain.Pet) (dst *model.Pet) {
+	if pet == nil {
+		return
+	}
+
 	dst = &model.Pet{}
 	dst.ID = pet.ID()
-	// no match: dst.Category.CategoryID
-	dst.Category.Name = pet.Category().Name()
+	dst.Category = domainCategoryToModelCategory(pet.Category())
 	dst.Name = pet.Name()
 	// no match: dst.PhotoUrls
 	// no match: dst.Status
 
 	return
 }
+
+func domainCategoryToModelCategory(src domain.Category) (dst model.Category) {
+	// no match: dst.CategoryID
+	dst.Name = src.Name()
+
+	return
+}
@@ -0,0 +1,67 @@
+// Code generated by github.com/reedom/convergen
+// DO NOT EDIT.
+
+package intfrules
+
+import (
+	"strings"
+)
+
+type User struct {
+	ID    int
+	Name  string
+	Email string
+}
+
+type Group struct {
+	ID   int
+	Name string
+}
+
+type UserModel struct {
+	ID      int
+	Name    string
+	Mail    string
+	Version int
+}
+
+type GroupModel struct {
+	ID      int
+	Name    string
+	Version int
+}
+
+func GroupToModel(src *Group) (dst *GroupModel) {
+	if src == nil {
+		return
+	}
+
+	dst = &GroupModel{}
+	dst.ID = src.ID
+	dst.Name = lower(src.Name)
+	dst.Version = 2
+
+	return
+}
+
+func UserToModel(src *User) (dst *UserModel) {
+	if src == nil {
+		return
+	}
+
+	dst = &UserModel{}
+	dst.ID = src.ID
+	dst.Name = upper(src.Name)
+	dst.Mail = src.Email
+	dst.Version = 1
+
+	return
+}
+
+func upper(s string) string {
+	return strings.ToUpper(s)
+}
+
+func lower(s string) string {
+	return strings.ToLower(s)
+}
@@ -0,0 +1,50 @@
+//go:build convergen
+
+package intfrules
+
+import (
+	"strings"
+)
+
+type User struct {
+	ID    int
+	Name  string
+	Email string
+}
+
+type Group struct {
+	ID   int
+	Name string
+}
+
+type UserModel struct {
+	ID      int
+	Name    string
+	Mail    string
+	Version int
+}
+
+type GroupModel struct {
+	ID      int
+	Name    string
+	Version int
+}
+
+//go:generate go run github.com/reedom/convergen
+// :map Email Mail
+// :conv upper Name
+// :literal Version 1
+type Convergen interface {
+	UserToModel(*User) *UserModel
+	// :conv lower Name
+	// :literal Version 2
+	GroupToModel(*Group) *GroupModel
+}
+
+func upper(s string) string {
+	return strings.ToUpper(s)
+}
+
+func lower(s string) string {
+	return strings.ToLower(s)
+}
@@ -9,10 +9,13 @@ import (
 )
 
 func DomainToModel(src *domain.Pet) (dst *model.Pet) {
+	if src == nil {
+		return
+	}
+
 	dst = &model.Pet{}
 	// no match: dst.ID
-	// no match: dst.Category.CategoryID
-	dst.Category.Name = src.Category.Name
+	dst.Category = domainCategoryToModelCategory(src.Category)
 	dst.Name = "abc  def"
 	// no match: dst.PhotoUrls
 	// no match: dst.Status
@@ -21,13 +24,30 @@ func DomainToModel(src *domain.Pet) (dst *model.Pet) {
 }
 
 func ModelToDomain(src *model.Pet) (dst *domain.Pet) {
+	if src == nil {
+		return
+	}
+
 	dst = &domain.Pet{}
 	// no match: dst.ID
-	// no match: dst.Category.ID
-	dst.Category.Name = src.Category.Name
+	dst.Category = modelCategoryToDomainCategory(src.Category)
 	dst.Name = src.Name
 	// no match: dst.PhotoUrls
 	// no match: dst.Status
 
 	return
 }
+
+func domainCategoryToModelCategory(src domain.Category) (dst model.Category) {
+	// no match: dst.CategoryID
+	dst.Name = src.Name
+
+	return
+}
+
+func modelCategoryToDomainCategory(src model.Category) (dst domain.Category) {
+	// no match: dst.ID
+	dst.Name = src.Name
+
+	return
+}
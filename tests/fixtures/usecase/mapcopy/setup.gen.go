@@ -0,0 +1,114 @@
+// Code generated by github.com/reedom/convergen
+// DO NOT EDIT.
+
+package mapcopy
+
+import (
+	"strconv"
+)
+
+type Level int
+
+type Labels map[string]string
+
+type Pet struct {
+	ID   int
+	Name string
+}
+
+type PetModel struct {
+	ID   int64
+	Name string
+}
+
+type Owner struct {
+	Scores  map[string]int
+	Levels  map[string]int
+	Counts  map[string]int
+	Ranks   map[int]string
+	Pets    map[string]*Pet
+	Friends map[string]Pet
+	Labels  Labels
+	Aliases map[string]string
+}
+
+type OwnerModel struct {
+	Scores  map[string]int
+	Levels  map[string]Level
+	Counts  map[string]string
+	Ranks   map[string]string
+	Pets    map[string]*PetModel
+	Friends map[string]PetModel
+	Labels  map[string]string
+	Aliases Labels
+}
+
+func OwnerToModel(src *Owner) (dst *OwnerModel) {
+	if src == nil {
+		return
+	}
+
+	dst = &OwnerModel{}
+	if src.Scores != nil {
+		dst.Scores = make(map[string]int, len(src.Scores))
+		for k, v := range src.Scores {
+			dst.Scores[k] = v
+		}
+	}
+	if src.Levels != nil {
+		dst.Levels = make(map[string]Level, len(src.Levels))
+		for k, v := range src.Levels {
+			dst.Levels[k] = Level(v)
+		}
+	}
+	if src.Counts != nil {
+		dst.Counts = make(map[string]string, len(src.Counts))
+		for k, v := range src.Counts {
+			dst.Counts[k] = strconv.Itoa(v)
+		}
+	}
+	if src.Ranks != nil {
+		dst.Ranks = make(map[string]string, len(src.Ranks))
+		for k, v := range src.Ranks {
+			dst.Ranks[strconv.Itoa(k)] = v
+		}
+	}
+	if src.Pets != nil {
+		dst.Pets = make(map[string]*PetModel, len(src.Pets))
+		for k, v := range src.Pets {
+			dst.Pets[k] = PetToModel(v)
+		}
+	}
+	if src.Friends != nil {
+		dst.Friends = make(map[string]PetModel, len(src.Friends))
+		for k, v := range src.Friends {
+			dst.Friends[k] = *PetToModel(&v)
+		}
+	}
+	if src.Labels != nil {
+		dst.Labels = make(map[string]string, len(src.Labels))
+		for k, v := range src.Labels {
+			dst.Labels[k] = v
+		}
+	}
+	if src.Aliases != nil {
+		dst.Aliases = make(Labels, len(src.Aliases))
+		for k, v := range src.Aliases {
+			dst.Aliases[k] = v
+		}
+	}
+
+	return
+}
+
+func PetToModel(src *Pet) (dst *PetModel) {
+	if src == nil {
+		return
+	}
+
+	dst = &PetModel{}
+	dst.ID = int64(src.ID)
+	dst.Name = src.Name
+
+	return
+}
@@ -0,0 +1,53 @@
+//go:build convergen
+
+package mapcopy
+
+import (
+	"strconv"
+)
+
+type Level int
+
+type Labels map[string]string
+
+type Pet struct {
+	ID   int
+	Name string
+}
+
+type PetModel struct {
+	ID   int64
+	Name string
+}
+
+type Owner struct {
+	Scores  map[string]int
+	Levels  map[string]int
+	Counts  map[string]int
+	Ranks   map[int]string
+	Pets    map[string]*Pet
+	Friends map[string]Pet
+	Labels  Labels
+	Aliases map[string]string
+}
+
+type OwnerModel struct {
+	Scores  map[string]int
+	Levels  map[string]Level
+	Counts  map[string]string
+	Ranks   map[string]string
+	Pets    map[string]*PetModel
+	Friends map[string]PetModel
+	Labels  map[string]string
+	Aliases Labels
+}
+
+//go:generate go run github.com/reedom/convergen
+type Convergen interface {
+	// :typecast
+	// :conv strconv.Itoa Counts[]
+	// :conv strconv.Itoa Ranks[key]
+	OwnerToModel(*Owner) *OwnerModel
+	// :typecast
+	PetToModel(*Pet) *PetModel
+}
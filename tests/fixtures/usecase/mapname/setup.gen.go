@@ -9,6 +9,10 @@ import (
 )
 
 func DomainToModel(src *domain.Pet) (dst *model.Pet) {
+	if src == nil {
+		return
+	}
+
 	dst = &model.Pet{}
 	dst.ID = uint64(src.ID)
 	dst.Category.CategoryID = uint64(src.Category.ID)
@@ -22,6 +22,10 @@ func (t *JSONDate) Time() time.Time {
 }
 
 func FromTo(src *From) (dst *To) {
+	if src == nil {
+		return
+	}
+
 	dst = &To{}
 	dst.JSONDate = src.JSONDate.Time()
 
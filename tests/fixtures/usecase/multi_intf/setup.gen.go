@@ -16,6 +16,10 @@ type StorageModel struct {
 }
 
 func (d *DomainModel) ToStorage() (dst *StorageModel) {
+	if d == nil {
+		return
+	}
+
 	dst = &StorageModel{}
 	dst.ID = d.ID
 
@@ -23,6 +27,10 @@ func (d *DomainModel) ToStorage() (dst *StorageModel) {
 }
 
 func (d *DomainModel) ToTransport() (dst *TransportModel) {
+	if d == nil {
+		return
+	}
+
 	dst = &TransportModel{}
 	dst.ID = d.ID
 
@@ -30,6 +38,10 @@ func (d *DomainModel) ToTransport() (dst *TransportModel) {
 }
 
 func (s *StorageModel) ToDomain() (dst *DomainModel) {
+	if s == nil {
+		return
+	}
+
 	dst = &DomainModel{}
 	dst.ID = s.ID
 
@@ -37,6 +49,10 @@ func (s *StorageModel) ToDomain() (dst *DomainModel) {
 }
 
 func (s *StorageModel) ToTransport() (dst *TransportModel) {
+	if s == nil {
+		return
+	}
+
 	dst = &TransportModel{}
 	dst.ID = s.ID
 
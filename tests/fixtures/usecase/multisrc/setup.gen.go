@@ -0,0 +1,165 @@
+// Code generated by github.com/reedom/convergen
+// DO NOT EDIT.
+
+package multisrc
+
+import (
+	"time"
+)
+
+type User struct {
+	ID    int64
+	Name  string
+	Email string
+}
+
+type Profile struct {
+	Name   string
+	Bio    string
+	Avatar string
+}
+
+type Options struct {
+	Name string
+}
+
+type UserResponse struct {
+	ID        int64
+	Name      string
+	Email     string
+	Bio       string
+	AvatarURL string
+	UpdatedAt time.Time
+}
+
+func ToResponse(user *User, profile *Profile) (dst *UserResponse) {
+	if user == nil && profile == nil {
+		return
+	}
+
+	dst = &UserResponse{}
+	if user != nil {
+		dst.ID = user.ID
+	}
+	if user != nil {
+		dst.Name = user.Name
+	} else {
+		if profile != nil {
+			dst.Name = profile.Name
+		}
+	}
+	if user != nil {
+		dst.Email = user.Email
+	}
+	if profile != nil {
+		dst.Bio = profile.Bio
+	}
+	if profile != nil {
+		dst.AvatarURL = profile.Avatar
+	}
+	// skip: dst.UpdatedAt
+
+	return
+}
+
+func ToResponseAt(user *User, profile *Profile, at time.Time) (dst *UserResponse) {
+	if user == nil && profile == nil {
+		return
+	}
+
+	dst = &UserResponse{}
+	if user != nil {
+		dst.ID = user.ID
+	}
+	if user != nil {
+		dst.Name = user.Name
+	} else {
+		if profile != nil {
+			dst.Name = profile.Name
+		}
+	}
+	if user != nil {
+		dst.Email = user.Email
+	}
+	if profile != nil {
+		dst.Bio = profile.Bio
+	}
+	if profile != nil {
+		dst.AvatarURL = profile.Avatar
+	}
+	// skip: dst.UpdatedAt
+	Touch(dst, user, at)
+
+	return
+}
+
+func ToResponsePreferProfile(user *User, profile *Profile) (dst *UserResponse) {
+	if user == nil && profile == nil {
+		return
+	}
+
+	dst = &UserResponse{}
+	if user != nil {
+		dst.ID = user.ID
+	}
+	if profile != nil {
+		dst.Name = profile.Name
+	} else {
+		if user != nil {
+			dst.Name = user.Name
+		}
+	}
+	if user != nil {
+		dst.Email = user.Email
+	}
+	if profile != nil {
+		dst.Bio = profile.Bio
+	}
+	if profile != nil {
+		dst.AvatarURL = profile.Avatar
+	}
+	// skip: dst.UpdatedAt
+
+	return
+}
+
+func ToResponseWith(user *User, profile *Profile, opts *Options) (dst *UserResponse) {
+	if user == nil && profile == nil {
+		return
+	}
+
+	dst = &UserResponse{}
+	if user != nil {
+		dst.ID = user.ID
+	}
+	if user != nil {
+		dst.Name = user.Name
+	} else {
+		if profile != nil {
+			dst.Name = profile.Name
+		}
+	}
+	if user != nil {
+		dst.Email = user.Email
+	}
+	if profile != nil {
+		dst.Bio = profile.Bio
+	}
+	if profile != nil {
+		dst.AvatarURL = profile.Avatar
+	}
+	// skip: dst.UpdatedAt
+	ApplyOptions(dst, user, opts)
+
+	return
+}
+
+func Touch(dst *UserResponse, src *User, at time.Time) {
+	dst.UpdatedAt = at
+}
+
+func ApplyOptions(dst *UserResponse, src *User, opts *Options) {
+	if opts != nil && opts.Name != "" {
+		dst.Name = opts.Name
+	}
+}
@@ -0,0 +1,62 @@
+//go:build convergen
+
+package multisrc
+
+import (
+	"time"
+)
+
+type User struct {
+	ID    int64
+	Name  string
+	Email string
+}
+
+type Profile struct {
+	Name   string
+	Bio    string
+	Avatar string
+}
+
+type Options struct {
+	Name string
+}
+
+type UserResponse struct {
+	ID        int64
+	Name      string
+	Email     string
+	Bio       string
+	AvatarURL string
+	UpdatedAt time.Time
+}
+
+//go:generate go run github.com/reedom/convergen
+type Convergen interface {
+	// :map profile.Avatar AvatarURL
+	// :skip UpdatedAt
+	ToResponse(user *User, profile *Profile) *UserResponse
+	// :precedence profile
+	// :map profile.Avatar AvatarURL
+	// :skip UpdatedAt
+	ToResponsePreferProfile(user *User, profile *Profile) *UserResponse
+	// :map profile.Avatar AvatarURL
+	// :skip UpdatedAt
+	// :postprocess Touch
+	ToResponseAt(user *User, profile *Profile, at time.Time) *UserResponse
+	// :src user profile
+	// :map profile.Avatar AvatarURL
+	// :skip UpdatedAt
+	// :postprocess ApplyOptions
+	ToResponseWith(user *User, profile *Profile, opts *Options) *UserResponse
+}
+
+func Touch(dst *UserResponse, src *User, at time.Time) {
+	dst.UpdatedAt = at
+}
+
+func ApplyOptions(dst *UserResponse, src *User, opts *Options) {
+	if opts != nil && opts.Name != "" {
+		dst.Name = opts.Name
+	}
+}
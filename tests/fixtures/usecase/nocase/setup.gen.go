@@ -24,6 +24,10 @@ type ModelB struct {
 // AtoB demonstrates local to local copy with case-insensitive field matching.
 // It shows that a private getter precedence over its (exported) counterpart field.
 func AtoB(src *ModelA) (dst *ModelB) {
+	if src == nil {
+		return
+	}
+
 	dst = &ModelB{}
 	dst.id = src.ID
 	dst.name = src.name()
@@ -32,6 +36,10 @@ func AtoB(src *ModelA) (dst *ModelB) {
 }
 
 func BtoA(src *ModelB) (dst *ModelA) {
+	if src == nil {
+		return
+	}
+
 	dst = &ModelA{}
 	dst.ID = src.id
 	dst.Name = src.name
@@ -42,6 +50,10 @@ func BtoA(src *ModelB) (dst *ModelA) {
 // BtoUser demonstrates copy an internal to external package type.
 // It skips private fields (and getters) in the latter type.
 func BtoUser(src *ModelB) (dst *model.User) {
+	if src == nil {
+		return
+	}
+
 	dst = &model.User{}
 	dst.Name = src.name
 
@@ -51,6 +63,10 @@ func BtoUser(src *ModelB) (dst *model.User) {
 // UserToB demonstrates copy an external package type to internal.
 // It skips private fields (and getters) in the former type.
 func UserToB(src *model.User) (dst *ModelB) {
+	if src == nil {
+		return
+	}
+
 	dst = &ModelB{}
 	// no match: dst.id
 	dst.name = src.Name
@@ -0,0 +1,40 @@
+// Code generated by github.com/reedom/convergen
+// DO NOT EDIT.
+
+package normalized
+
+type Row struct {
+	user_id    int
+	user_name  string
+	m_home_url string
+	email_col  string
+	Phone      string
+	phone      string
+	zip_code   string
+	ZIPCode    string
+}
+
+type User struct {
+	UserID  int
+	Name    string
+	HomeURL string
+	Email   string
+	Phone   string
+	ZipCode string
+}
+
+func RowToUser(src *Row) (dst *User) {
+	if src == nil {
+		return
+	}
+
+	dst = &User{}
+	dst.UserID = src.user_id
+	dst.Name = src.user_name
+	dst.HomeURL = src.m_home_url
+	dst.Email = src.email_col
+	dst.Phone = src.Phone
+	// no match: dst.ZipCode
+
+	return
+}
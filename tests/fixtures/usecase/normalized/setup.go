@@ -0,0 +1,29 @@
+//go:build convergen
+
+package normalized
+
+type Row struct {
+	user_id    int
+	user_name  string
+	m_home_url string
+	email_col  string
+	Phone      string
+	phone      string
+	zip_code   string
+	ZIPCode    string
+}
+
+type User struct {
+	UserID  int
+	Name    string
+	HomeURL string
+	Email   string
+	Phone   string
+	ZipCode string
+}
+
+//go:generate go run github.com/reedom/convergen
+// :match normalized prefix=m prefix=user suffix=col
+type Convergen interface {
+	RowToUser(*Row) *User
+}
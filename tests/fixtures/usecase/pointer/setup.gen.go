@@ -0,0 +1,76 @@
+// Code generated by github.com/reedom/convergen
+// DO NOT EDIT.
+
+package pointer
+
+import (
+	"strconv"
+)
+
+type Status int
+
+func (s Status) String() string {
+	return strconv.Itoa(int(s))
+}
+
+type Input struct {
+	Name   *string
+	Nick   string
+	Age    *int
+	Score  *int
+	Status *Status
+	Rank   *int
+}
+
+type Output struct {
+	Name   string
+	Nick   *string
+	Age    *int64
+	Score  string
+	Status string
+	Rank   int
+}
+
+func InputToOutput(src *Input) (dst *Output) {
+	if src == nil {
+		return
+	}
+
+	dst = &Output{}
+	if src.Name != nil {
+		dst.Name = *src.Name
+	}
+	dst.Nick = new(string)
+	*dst.Nick = src.Nick
+	if src.Age != nil {
+		dst.Age = new(int64)
+		*dst.Age = int64(*src.Age)
+	}
+	if src.Score != nil {
+		dst.Score = strconv.Itoa(*src.Score)
+	}
+	if src.Status != nil {
+		dst.Status = (*src.Status).String()
+	}
+	if src.Rank != nil {
+		dst.Rank = *src.Rank
+	}
+
+	return
+}
+
+func InputToOutputNoPtr(src *Input) (dst *Output) {
+	if src == nil {
+		return
+	}
+
+	dst = &Output{}
+	// no match: dst.Name
+	// no match: dst.Nick
+	// no match: dst.Age
+	// no match: dst.Score
+	// no match: dst.Status
+	// no match: dst.Rank
+
+	return
+}
@@ -0,0 +1,41 @@
+//go:build convergen
+
+package pointer
+
+import (
+	"strconv"
+)
+
+type Status int
+
+func (s Status) String() string {
+	return strconv.Itoa(int(s))
+}
+
+type Input struct {
+	Name   *string
+	Nick   string
+	Age    *int
+	Score  *int
+	Status *Status
+	Rank   *int
+}
+
+type Output struct {
+	Name   string
+	Nick   *string
+	Age    *int64
+	Score  string
+	Status string
+	Rank   int
+}
+
+//go:generate go run github.com/reedom/convergen
+type Convergen interface {
+	// :typecast
+	// :stringer
+	// :conv strconv.Itoa Score
+	InputToOutput(*Input) *Output
+	// :ptr:off
+	InputToOutputNoPtr(*Input) *Output
+}
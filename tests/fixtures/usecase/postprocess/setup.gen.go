@@ -11,11 +11,14 @@ import (
 )
 
 func DomainToModel(src *domain.Pet) (dst *model.Pet, err error) {
+	if src == nil {
+		return
+	}
+
 	dst = &model.Pet{}
 	PreDomainToModel(dst, *src)
 	// no match: dst.ID
-	// no match: dst.Category.CategoryID
-	dst.Category.Name = src.Category.Name
+	dst.Category = domainCategoryToModelCategory(src.Category)
 	dst.Name = src.Name
 	// no match: dst.PhotoUrls
 	// no match: dst.Status
@@ -28,10 +31,13 @@ func DomainToModel(src *domain.Pet) (dst *model.Pet, err error) {
 }
 
 func ModelToDomain(src *model.Pet) (dst *domain.Pet, err error) {
+	if src == nil {
+		return
+	}
+
 	dst = &domain.Pet{}
 	// no match: dst.ID
-	// no match: dst.Category.ID
-	dst.Category.Name = src.Category.Name
+	dst.Category = modelCategoryToDomainCategory(src.Category)
 	dst.Name = src.Name
 	// no match: dst.PhotoUrls
 	// no match: dst.Status
@@ -43,6 +49,20 @@ func ModelToDomain(src *model.Pet) (dst *domain.Pet, err error) {
 	return
 }
 
+func domainCategoryToModelCategory(src domain.Category) (dst model.Category) {
+	// no match: dst.CategoryID
+	dst.Name = src.Name
+
+	return
+}
+
+func modelCategoryToDomainCategory(src model.Category) (dst domain.Category) {
+	// no match: dst.ID
+	dst.Name = src.Name
+
+	return
+}
+
 func PreDomainToModel(lhs *model.Pet, rhs domain.Pet) {
 }
 
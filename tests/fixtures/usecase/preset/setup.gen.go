@@ -0,0 +1,65 @@
+// Code generated by github.com/reedom/convergen
+// DO NOT EDIT.
+
+package preset
+
+import (
+	"time"
+)
+
+type Article struct {
+	Title   string
+	Body    string
+	Created time.Time
+	Updated time.Time
+}
+
+type Comment struct {
+	Body    string
+	Created time.Time
+	Updated time.Time
+}
+
+type ArticleModel struct {
+	Title   string
+	Body    string
+	Created int64
+	Updated int64
+}
+
+type CommentModel struct {
+	Body    string
+	Created int64
+	Updated int64
+}
+
+func ArticleToModel(src *Article) (dst *ArticleModel) {
+	if src == nil {
+		return
+	}
+
+	dst = &ArticleModel{}
+	dst.Title = src.Title
+	dst.Body = src.Body
+	dst.Created = toUnix(src.Created)
+	dst.Updated = toUnix(src.Updated)
+
+	return
+}
+
+func CommentToModel(src *Comment) (dst *CommentModel) {
+	if src == nil {
+		return
+	}
+
+	dst = &CommentModel{}
+	dst.Body = src.Body
+	dst.Created = toUnix(src.Created)
+	// skip: dst.Updated
+
+	return
+}
+
+func toUnix(t time.Time) int64 {
+	return t.Unix()
+}
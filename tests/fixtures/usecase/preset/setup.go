@@ -0,0 +1,51 @@
+//go:build convergen
+
+package preset
+
+import (
+	"time"
+)
+
+type Article struct {
+	Title   string
+	Body    string
+	Created time.Time
+	Updated time.Time
+}
+
+type Comment struct {
+	Body    string
+	Created time.Time
+	Updated time.Time
+}
+
+type ArticleModel struct {
+	Title   string
+	Body    string
+	Created int64
+	Updated int64
+}
+
+type CommentModel struct {
+	Body    string
+	Created int64
+	Updated int64
+}
+
+// :preset timestamps
+// :conv toUnix Created
+// :conv toUnix Updated
+type timestamps interface{}
+
+//go:generate go run github.com/reedom/convergen
+type Convergen interface {
+	// :use timestamps
+	ArticleToModel(*Article) *ArticleModel
+	// :use timestamps
+	// :skip Updated
+	CommentToModel(*Comment) *CommentModel
+}
+
+func toUnix(t time.Time) int64 {
+	return t.Unix()
+}
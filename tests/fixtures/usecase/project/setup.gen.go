@@ -0,0 +1,50 @@
+// Copyright 2026 The Convergen Authors.
+
+// Code generated by github.com/reedom/convergen
+// DO NOT EDIT.
+
+package project
+
+type UserID int64
+
+type User struct {
+	ID       UserID
+	Name     string
+	Email    string
+	Password string
+}
+
+type UserModel struct {
+	ID       int64
+	Name     string
+	Mail     string
+	Password string
+}
+
+func ToModel(src *User) (dst *UserModel) {
+	if src == nil {
+		return
+	}
+
+	dst = &UserModel{}
+	dst.ID = int64(src.ID)
+	dst.Name = src.Name
+	dst.Mail = src.Email
+	// skip: dst.Password
+
+	return
+}
+
+func ToModelWithoutID(src *User) (dst *UserModel) {
+	if src == nil {
+		return
+	}
+
+	dst = &UserModel{}
+	// skip: dst.ID
+	dst.Name = src.Name
+	dst.Mail = src.Email
+	// skip: dst.Password
+
+	return
+}
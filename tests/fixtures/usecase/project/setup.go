@@ -0,0 +1,27 @@
+//go:build convergen
+
+package project
+
+type UserID int64
+
+type User struct {
+	ID       UserID
+	Name     string
+	Email    string
+	Password string
+}
+
+type UserModel struct {
+	ID       int64
+	Name     string
+	Mail     string
+	Password string
+}
+
+//go:generate go run github.com/reedom/convergen
+type Convergen interface {
+	ToModel(*User) *UserModel
+	// :typecast:off
+	// :skip ID
+	ToModelWithoutID(*User) *UserModel
+}
@@ -0,0 +1,56 @@
+// Code generated by github.com/reedom/convergen
+// DO NOT EDIT.
+
+package projectintf
+
+type A struct {
+	Name  string
+	Email string
+	Phone string
+	Foo   string
+}
+
+type B struct {
+	Title string
+	Mail  string
+	Tel   string
+	FooA  string
+	BarB  string
+}
+
+type C struct {
+	Name  string
+	Email string
+	Phone string
+	Bar   string
+}
+
+func AToB(src *A) (dst *B) {
+	if src == nil {
+		return
+	}
+
+	dst = &B{}
+	dst.Title = src.Name
+	dst.Mail = src.Email
+	dst.Tel = src.Phone
+	dst.FooA = src.Foo
+	// no match: dst.BarB
+
+	return
+}
+
+func CToB(src *C) (dst *B) {
+	if src == nil {
+		return
+	}
+
+	dst = &B{}
+	dst.Title = src.Name
+	dst.Mail = src.Email
+	dst.Tel = src.Phone
+	// no match: dst.FooA
+	dst.BarB = src.Bar
+
+	return
+}
@@ -0,0 +1,39 @@
+//go:build convergen
+
+package projectintf
+
+type A struct {
+	Name  string
+	Email string
+	Phone string
+	Foo   string
+}
+
+type B struct {
+	Title string
+	Mail  string
+	Tel   string
+	FooA  string
+	BarB  string
+}
+
+type C struct {
+	Name  string
+	Email string
+	Phone string
+	Bar   string
+}
+
+// The project rules are shared by the interfaces, each of which adds its own rule.
+//
+//go:generate go run github.com/reedom/convergen
+// :map Foo FooA
+type Convergen interface {
+	AToB(*A) *B
+}
+
+// :convergen
+// :map Bar BarB
+type OtherConverter interface {
+	CToB(*C) *B
+}
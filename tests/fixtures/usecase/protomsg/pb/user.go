@@ -0,0 +1,156 @@
+// Package pb declares messages in the shape that protoc-gen-go generates for:
+//
+//	message Address {
+//	  string city = 1;
+//	}
+//
+//	message User {
+//	  int64 id = 1;
+//	  string name = 2;
+//	  google.protobuf.StringValue nickname = 3;
+//	  google.protobuf.Int64Value age = 4;
+//	  google.protobuf.Timestamp created_at = 5;
+//	  google.protobuf.Timestamp deleted_at = 6;
+//	  google.protobuf.Duration timeout = 7;
+//	  Address address = 8;
+//	  oneof contact {
+//	    string email = 9;
+//	    string phone = 10;
+//	  }
+//	}
+package pb
+
+import (
+	"google.golang.org/protobuf/runtime/protoimpl"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type Address struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	City string `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+}
+
+func (x *Address) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+type User struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Nickname  *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Age       *wrapperspb.Int64Value  `protobuf:"bytes,4,opt,name=age,proto3" json:"age,omitempty"`
+	CreatedAt *timestamppb.Timestamp  `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DeletedAt *timestamppb.Timestamp  `protobuf:"bytes,6,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	Timeout   *durationpb.Duration    `protobuf:"bytes,7,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Address   *Address                `protobuf:"bytes,8,opt,name=address,proto3" json:"address,omitempty"`
+	// Types that are assignable to Contact:
+	//
+	//	*User_Email
+	//	*User_Phone
+	Contact isUser_Contact `protobuf_oneof:"contact"`
+}
+
+func (x *User) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *User) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *User) GetNickname() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Nickname
+	}
+	return nil
+}
+
+func (x *User) GetAge() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Age
+	}
+	return nil
+}
+
+func (x *User) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *User) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *User) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *User) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (m *User) GetContact() isUser_Contact {
+	if m != nil {
+		return m.Contact
+	}
+	return nil
+}
+
+func (x *User) GetEmail() string {
+	if x, ok := x.GetContact().(*User_Email); ok {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *User) GetPhone() string {
+	if x, ok := x.GetContact().(*User_Phone); ok {
+		return x.Phone
+	}
+	return ""
+}
+
+type isUser_Contact interface {
+	isUser_Contact()
+}
+
+type User_Email struct {
+	Email string `protobuf:"bytes,9,opt,name=email,proto3,oneof"`
+}
+
+type User_Phone struct {
+	Phone string `protobuf:"bytes,10,opt,name=phone,proto3,oneof"`
+}
+
+func (*User_Email) isUser_Contact() {}
+
+func (*User_Phone) isUser_Contact() {}
@@ -0,0 +1,102 @@
+// Code generated by github.com/reedom/convergen
+// DO NOT EDIT.
+
+package protomsg
+
+import (
+	"time"
+
+	"github.com/reedom/convergen/tests/fixtures/usecase/protomsg/pb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type Address struct {
+	City string
+}
+
+type User struct {
+	ID        int64
+	Name      string
+	Nickname  *string
+	Age       int64
+	CreatedAt time.Time
+	DeletedAt *time.Time
+	Timeout   time.Duration
+	Address   *Address
+	Email     *string
+	Phone     *string
+}
+
+func UserFromPb(src *pb.User) (dst *User) {
+	if src == nil {
+		return
+	}
+
+	dst = &User{}
+	dst.ID = src.GetId()
+	dst.Name = src.GetName()
+	if src.GetNickname() != nil {
+		dst.Nickname = new(string)
+		*dst.Nickname = src.GetNickname().GetValue()
+	}
+	if src.GetAge() != nil {
+		dst.Age = src.GetAge().GetValue()
+	}
+	if src.GetCreatedAt() != nil {
+		dst.CreatedAt = src.GetCreatedAt().AsTime()
+	}
+	if src.GetDeletedAt() != nil {
+		dst.DeletedAt = new(time.Time)
+		*dst.DeletedAt = src.GetDeletedAt().AsTime()
+	}
+	if src.GetTimeout() != nil {
+		dst.Timeout = src.GetTimeout().AsDuration()
+	}
+	if src.GetAddress() != nil {
+		dst.Address = &Address{}
+		dst.Address.City = src.GetAddress().GetCity()
+	}
+	switch v := src.GetContact().(type) {
+	case *pb.User_Email:
+		dst.Email = new(string)
+		*dst.Email = v.Email
+	case *pb.User_Phone:
+		dst.Phone = new(string)
+		*dst.Phone = v.Phone
+	}
+
+	return
+}
+
+func UserToPb(src *User) (dst *pb.User) {
+	if src == nil {
+		return
+	}
+
+	dst = &pb.User{}
+	dst.Id = src.ID
+	dst.Name = src.Name
+	if src.Nickname != nil {
+		dst.Nickname = wrapperspb.String(*src.Nickname)
+	}
+	dst.Age = wrapperspb.Int64(src.Age)
+	dst.CreatedAt = timestamppb.New(src.CreatedAt)
+	if src.DeletedAt != nil {
+		dst.DeletedAt = timestamppb.New(*src.DeletedAt)
+	}
+	dst.Timeout = durationpb.New(src.Timeout)
+	if src.Address != nil {
+		dst.Address = &pb.Address{}
+		dst.Address.City = src.Address.City
+	}
+	switch {
+	case src.Email != nil:
+		dst.Contact = &pb.User_Email{Email: *src.Email}
+	case src.Phone != nil:
+		dst.Contact = &pb.User_Phone{Phone: *src.Phone}
+	}
+
+	return
+}
@@ -0,0 +1,36 @@
+//go:build convergen
+
+package protomsg
+
+import (
+	"time"
+
+	"github.com/reedom/convergen/tests/fixtures/usecase/protomsg/pb"
+)
+
+type Address struct {
+	City string
+}
+
+type User struct {
+	ID        int64
+	Name      string
+	Nickname  *string
+	Age       int64
+	CreatedAt time.Time
+	DeletedAt *time.Time
+	Timeout   time.Duration
+	Address   *Address
+	Email     *string
+	Phone     *string
+}
+
+//go:generate go run github.com/reedom/convergen
+type Convergen interface {
+	// :proto
+	// :case:off
+	UserFromPb(*pb.User) *User
+	// :proto
+	// :case:off
+	UserToPb(*User) *pb.User
+}
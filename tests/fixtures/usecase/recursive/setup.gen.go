@@ -0,0 +1,165 @@
+// Code generated by github.com/reedom/convergen
+// DO NOT EDIT.
+
+package recursive
+
+import "strconv"
+
+type Meta struct {
+	Version int
+}
+
+type MetaModel struct {
+	Version int64
+}
+
+type Node struct {
+	Name  string
+	Left  *Node
+	Right *Node
+	Meta  Meta
+}
+
+type NodeModel struct {
+	Name  string
+	Left  *NodeModel
+	Right *NodeModel
+	Meta  MetaModel
+}
+
+type Tree struct {
+	Root *Node
+	Meta Meta
+}
+
+type TreeModel struct {
+	Root *NodeModel
+	Meta MetaModel
+}
+
+type Category struct {
+	Name   string
+	Code   string
+	Parent *Category
+}
+
+type CategoryModel struct {
+	Name   string
+	Code   int
+	Parent *CategoryModel
+}
+
+func CategoryToModel(src *Category) (dst *CategoryModel, err error) {
+	if src == nil {
+		return
+	}
+
+	dst = &CategoryModel{}
+	dst.Name = src.Name
+	dst.Code, err = ParseCode(src.Code)
+	if err != nil {
+		return nil, err
+	}
+	if src.Parent != nil {
+		dst.Parent = new(CategoryModel)
+		*dst.Parent, err = categoryToCategoryModel(*src.Parent)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+func TreeFromModel(src *TreeModel) (dst *Tree, err error) {
+	if src == nil {
+		return
+	}
+
+	dst = &Tree{}
+	if src.Root != nil {
+		dst.Root = new(Node)
+		*dst.Root = nodeModelToNode(*src.Root)
+	}
+	dst.Meta = metaModelToMeta(src.Meta)
+
+	return
+}
+
+func TreeToModel(src *Tree) (dst *TreeModel) {
+	if src == nil {
+		return
+	}
+
+	dst = &TreeModel{}
+	if src.Root != nil {
+		dst.Root = new(NodeModel)
+		*dst.Root = nodeToNodeModel(*src.Root)
+	}
+	dst.Meta = metaToMetaModel(src.Meta)
+
+	return
+}
+
+func categoryToCategoryModel(src Category) (dst CategoryModel, err error) {
+	dst.Name = src.Name
+	dst.Code, err = ParseCode(src.Code)
+	if err != nil {
+		return
+	}
+	if src.Parent != nil {
+		dst.Parent = new(CategoryModel)
+		*dst.Parent, err = categoryToCategoryModel(*src.Parent)
+	}
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func nodeModelToNode(src NodeModel) (dst Node) {
+	dst.Name = src.Name
+	if src.Left != nil {
+		dst.Left = new(Node)
+		*dst.Left = nodeModelToNode(*src.Left)
+	}
+	if src.Right != nil {
+		dst.Right = new(Node)
+		*dst.Right = nodeModelToNode(*src.Right)
+	}
+	dst.Meta = metaModelToMeta(src.Meta)
+
+	return
+}
+
+func metaModelToMeta(src MetaModel) (dst Meta) {
+	dst.Version = int(src.Version)
+
+	return
+}
+
+func nodeToNodeModel(src Node) (dst NodeModel) {
+	dst.Name = src.Name
+	if src.Left != nil {
+		dst.Left = new(NodeModel)
+		*dst.Left = nodeToNodeModel(*src.Left)
+	}
+	if src.Right != nil {
+		dst.Right = new(NodeModel)
+		*dst.Right = nodeToNodeModel(*src.Right)
+	}
+	dst.Meta = metaToMetaModel(src.Meta)
+
+	return
+}
+
+func metaToMetaModel(src Meta) (dst MetaModel) {
+	dst.Version = int64(src.Version)
+
+	return
+}
+
+func ParseCode(code string) (int, error) {
+	return strconv.Atoi(code)
+}
@@ -0,0 +1,63 @@
+//go:build convergen
+
+package recursive
+
+import "strconv"
+
+type Meta struct {
+	Version int
+}
+
+type MetaModel struct {
+	Version int64
+}
+
+type Node struct {
+	Name  string
+	Left  *Node
+	Right *Node
+	Meta  Meta
+}
+
+type NodeModel struct {
+	Name  string
+	Left  *NodeModel
+	Right *NodeModel
+	Meta  MetaModel
+}
+
+type Tree struct {
+	Root *Node
+	Meta Meta
+}
+
+type TreeModel struct {
+	Root *NodeModel
+	Meta MetaModel
+}
+
+type Category struct {
+	Name   string
+	Code   string
+	Parent *Category
+}
+
+type CategoryModel struct {
+	Name   string
+	Code   int
+	Parent *CategoryModel
+}
+
+//go:generate go run github.com/reedom/convergen
+type Convergen interface {
+	// :typecast
+	TreeToModel(*Tree) *TreeModel
+	// :typecast
+	TreeFromModel(*TreeModel) (*Tree, error)
+	// :conv:type ParseCode string int
+	CategoryToModel(*Category) (*CategoryModel, error)
+}
+
+func ParseCode(code string) (int, error) {
+	return strconv.Atoi(code)
+}
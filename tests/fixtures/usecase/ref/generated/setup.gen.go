@@ -9,6 +9,10 @@ import (
 )
 
 func CatDomainToModel(src *domain.Category) (dst model.Category) {
+	if src == nil {
+		return
+	}
+
 	dst.CategoryID = uint64(src.ID)
 	dst.Name = src.Name
 
@@ -16,6 +20,10 @@ func CatDomainToModel(src *domain.Category) (dst model.Category) {
 }
 
 func DomainToModel(src *domain.Pet) (dst *model.Pet) {
+	if src == nil {
+		return
+	}
+
 	dst = &model.Pet{}
 	// no match: dst.ID
 	dst.Category = CatDomainToModel(&src.Category)
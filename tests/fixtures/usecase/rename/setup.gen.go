@@ -0,0 +1,57 @@
+// Code generated by github.com/reedom/convergen
+// DO NOT EDIT.
+
+package rename
+
+type Record struct {
+	SrcID     int
+	SrcName   string
+	BizStatus string
+	Note      string
+}
+
+type Entity struct {
+	ID     int
+	Name   string
+	Status string
+	Note   string
+}
+
+type Legacy struct {
+	OldID   int
+	OldName string
+	Note    string
+}
+
+type Model struct {
+	NewID   int
+	NewName string
+	Note    string
+}
+
+func LegacyToModel(src *Legacy) (dst *Model) {
+	if src == nil {
+		return
+	}
+
+	dst = &Model{}
+	dst.NewID = src.OldID
+	dst.NewName = src.OldName
+	dst.Note = src.Note
+
+	return
+}
+
+func RecordToEntity(src *Record) (dst *Entity) {
+	if src == nil {
+		return
+	}
+
+	dst = &Entity{}
+	dst.ID = src.SrcID
+	dst.Name = src.SrcName
+	dst.Status = src.BizStatus
+	dst.Note = src.Note
+
+	return
+}
@@ -0,0 +1,38 @@
+//go:build convergen
+
+package rename
+
+type Record struct {
+	SrcID     int
+	SrcName   string
+	BizStatus string
+	Note      string
+}
+
+type Entity struct {
+	ID     int
+	Name   string
+	Status string
+	Note   string
+}
+
+type Legacy struct {
+	OldID   int
+	OldName string
+	Note    string
+}
+
+type Model struct {
+	NewID   int
+	NewName string
+	Note    string
+}
+
+//go:generate go run github.com/reedom/convergen
+// :map /^Src(.*)$/ $1
+type Convergen interface {
+	// :map:prefix Biz
+	RecordToEntity(*Record) *Entity
+	// :map:prefix Old New
+	LegacyToModel(*Legacy) *Model
+}
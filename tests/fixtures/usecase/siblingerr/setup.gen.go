@@ -0,0 +1,70 @@
+// Code generated by github.com/reedom/convergen
+// DO NOT EDIT.
+
+package siblingerr
+
+type Pet struct {
+	Name string
+}
+
+type PetModel struct {
+	Name string
+}
+
+type Owner struct {
+	Pets    []Pet
+	Friends []Pet
+	Pair    [2]Pet
+	ByName  map[string]Pet
+}
+
+type OwnerModel struct {
+	Pets    []PetModel
+	Friends []*PetModel
+	Pair    [2]PetModel
+	ByName  map[string]PetModel
+}
+
+func OwnerToModel(src *Owner) (dst *OwnerModel, err error) {
+	if src == nil {
+		return
+	}
+
+	dst = &OwnerModel{}
+	if src.Pets != nil {
+		dst.Pets = make([]PetModel, len(src.Pets))
+		for i, e := range src.Pets {
+			dst.Pets[i] = petToPetModel(e)
+		}
+	}
+	if src.Friends != nil {
+		dst.Friends = make([]*PetModel, len(src.Friends))
+		for i, e := range src.Friends {
+			dst.Friends[i], err = PetToModel(&e)
+			if err != nil {
+				return
+			}
+		}
+	}
+	// no match: dst.Pair
+	// no match: dst.ByName
+
+	return
+}
+
+func PetToModel(src *Pet) (dst *PetModel, err error) {
+	if src == nil {
+		return
+	}
+
+	dst = &PetModel{}
+	dst.Name = src.Name
+
+	return
+}
+
+func petToPetModel(src Pet) (dst PetModel) {
+	dst.Name = src.Name
+
+	return
+}
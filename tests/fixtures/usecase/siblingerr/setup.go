@@ -0,0 +1,31 @@
+//go:build convergen
+
+package siblingerr
+
+type Pet struct {
+	Name string
+}
+
+type PetModel struct {
+	Name string
+}
+
+type Owner struct {
+	Pets    []Pet
+	Friends []Pet
+	Pair    [2]Pet
+	ByName  map[string]Pet
+}
+
+type OwnerModel struct {
+	Pets    []PetModel
+	Friends []*PetModel
+	Pair    [2]PetModel
+	ByName  map[string]PetModel
+}
+
+//go:generate go run github.com/reedom/convergen
+type Convergen interface {
+	OwnerToModel(*Owner) (*OwnerModel, error)
+	PetToModel(*Pet) (*PetModel, error)
+}
@@ -20,6 +20,10 @@ type Data struct{}
 type Status int
 
 func Copy(src *SrcType) (dst *DstType) {
+	if src == nil {
+		return
+	}
+
 	dst = &DstType{}
 	if src.IntSlice != nil {
 		dst.IntSlice = make([]int, len(src.IntSlice))
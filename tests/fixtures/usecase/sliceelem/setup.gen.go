@@ -0,0 +1,140 @@
+// Code generated by github.com/reedom/convergen
+// DO NOT EDIT.
+
+package sliceelem
+
+type Item struct {
+	ID   int
+	Name string
+}
+
+type ItemModel struct {
+	ID   int64
+	Name string
+}
+
+type Tag struct {
+	Label string
+}
+
+type TagModel struct {
+	Label string
+	Color string
+}
+
+type Order struct {
+	Items   []*Item
+	Extras  []Item
+	Backups []*Item
+	Tags    []Tag
+}
+
+type OrderModel struct {
+	Items   []ItemModel
+	Extras  []*ItemModel
+	Backups []*ItemModel
+	Tags    []TagModel
+}
+
+func OrderToModel(src *Order) (dst *OrderModel) {
+	if src == nil {
+		return
+	}
+
+	dst = &OrderModel{}
+	if src.Items != nil {
+		dst.Items = make([]ItemModel, len(src.Items))
+		for i, e := range src.Items {
+			if e != nil {
+				dst.Items[i] = itemToItemModel(*e)
+			}
+		}
+	}
+	if src.Extras != nil {
+		dst.Extras = make([]*ItemModel, len(src.Extras))
+		for i, e := range src.Extras {
+			dst.Extras[i] = new(ItemModel)
+			*dst.Extras[i] = itemToItemModel(e)
+		}
+	}
+	if src.Backups != nil {
+		dst.Backups = make([]*ItemModel, len(src.Backups))
+		for i, e := range src.Backups {
+			if e != nil {
+				dst.Backups[i] = new(ItemModel)
+				*dst.Backups[i] = itemToItemModel(*e)
+			}
+		}
+	}
+	if src.Tags != nil {
+		dst.Tags = make([]TagModel, len(src.Tags))
+		for i, e := range src.Tags {
+			dst.Tags[i] = *TagToModel(&e)
+		}
+	}
+
+	return
+}
+
+func OrderToModelSkipNil(src *Order) (dst *OrderModel) {
+	if src == nil {
+		return
+	}
+
+	dst = &OrderModel{}
+	if src.Items != nil {
+		dst.Items = make([]ItemModel, 0, len(src.Items))
+		for _, e := range src.Items {
+			if e == nil {
+				continue
+			}
+			d := itemToItemModel(*e)
+			dst.Items = append(dst.Items, d)
+		}
+	}
+	if src.Extras != nil {
+		dst.Extras = make([]*ItemModel, len(src.Extras))
+		for i, e := range src.Extras {
+			dst.Extras[i] = new(ItemModel)
+			*dst.Extras[i] = itemToItemModel(e)
+		}
+	}
+	if src.Backups != nil {
+		dst.Backups = make([]*ItemModel, 0, len(src.Backups))
+		for _, e := range src.Backups {
+			if e == nil {
+				continue
+			}
+			d := new(ItemModel)
+			*d = itemToItemModel(*e)
+			dst.Backups = append(dst.Backups, d)
+		}
+	}
+	if src.Tags != nil {
+		dst.Tags = make([]TagModel, len(src.Tags))
+		for i, e := range src.Tags {
+			dst.Tags[i] = *TagToModel(&e)
+		}
+	}
+
+	return
+}
+
+func TagToModel(src *Tag) (dst *TagModel) {
+	if src == nil {
+		return
+	}
+
+	dst = &TagModel{}
+	dst.Label = src.Label
+	// skip: dst.Color
+
+	return
+}
+
+func itemToItemModel(src Item) (dst ItemModel) {
+	dst.ID = int64(src.ID)
+	dst.Name = src.Name
+
+	return
+}
@@ -0,0 +1,47 @@
+//go:build convergen
+
+package sliceelem
+
+type Item struct {
+	ID   int
+	Name string
+}
+
+type ItemModel struct {
+	ID   int64
+	Name string
+}
+
+type Tag struct {
+	Label string
+}
+
+type TagModel struct {
+	Label string
+	Color string
+}
+
+type Order struct {
+	Items   []*Item
+	Extras  []Item
+	Backups []*Item
+	Tags    []Tag
+}
+
+type OrderModel struct {
+	Items   []ItemModel
+	Extras  []*ItemModel
+	Backups []*ItemModel
+	Tags    []TagModel
+}
+
+//go:generate go run github.com/reedom/convergen
+type Convergen interface {
+	// :typecast
+	OrderToModel(*Order) *OrderModel
+	// :typecast
+	// :nil:skip
+	OrderToModelSkipNil(*Order) *OrderModel
+	// :skip Color
+	TagToModel(*Tag) *TagModel
+}
@@ -0,0 +1,90 @@
+// Code generated by github.com/reedom/convergen
+// DO NOT EDIT.
+
+package sqlnull
+
+import (
+	"database/sql"
+	"time"
+)
+
+type User struct {
+	ID        int64
+	Name      string
+	Nickname  *string
+	Age       int
+	Score     *float64
+	CreatedAt time.Time
+	DeletedAt *time.Time
+	Email     string
+}
+
+type UserRecord struct {
+	ID        int64
+	Name      sql.NullString
+	Nickname  sql.NullString
+	Age       sql.NullInt32
+	Score     sql.Null[float64]
+	CreatedAt sql.NullTime
+	DeletedAt sql.NullTime
+	Email     sql.Null[string]
+}
+
+func UserFromRecord(src *UserRecord) (dst *User) {
+	if src == nil {
+		return
+	}
+
+	dst = &User{}
+	dst.ID = src.ID
+	if src.Name.Valid {
+		dst.Name = src.Name.String
+	}
+	if src.Nickname.Valid {
+		dst.Nickname = new(string)
+		*dst.Nickname = src.Nickname.String
+	}
+	if src.Age.Valid {
+		dst.Age = int(src.Age.Int32)
+	}
+	if src.Score.Valid {
+		dst.Score = new(float64)
+		*dst.Score = src.Score.V
+	}
+	if src.CreatedAt.Valid {
+		dst.CreatedAt = src.CreatedAt.Time
+	}
+	if src.DeletedAt.Valid {
+		dst.DeletedAt = new(time.Time)
+		*dst.DeletedAt = src.DeletedAt.Time
+	}
+	if src.Email.Valid {
+		dst.Email = src.Email.V
+	}
+
+	return
+}
+
+func UserToRecord(src *User) (dst *UserRecord) {
+	if src == nil {
+		return
+	}
+
+	dst = &UserRecord{}
+	dst.ID = src.ID
+	dst.Name = sql.NullString{String: src.Name, Valid: true}
+	if src.Nickname != nil {
+		dst.Nickname = sql.NullString{String: *src.Nickname, Valid: true}
+	}
+	dst.Age = sql.NullInt32{Int32: int32(src.Age), Valid: true}
+	if src.Score != nil {
+		dst.Score = sql.Null[float64]{V: *src.Score, Valid: true}
+	}
+	dst.CreatedAt = sql.NullTime{Time: src.CreatedAt, Valid: true}
+	if src.DeletedAt != nil {
+		dst.DeletedAt = sql.NullTime{Time: *src.DeletedAt, Valid: true}
+	}
+	dst.Email = sql.Null[string]{V: src.Email, Valid: true}
+
+	return
+}
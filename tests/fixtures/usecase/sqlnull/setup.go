@@ -0,0 +1,40 @@
+//go:build convergen
+
+package sqlnull
+
+import (
+	"database/sql"
+	"time"
+)
+
+type User struct {
+	ID        int64
+	Name      string
+	Nickname  *string
+	Age       int
+	Score     *float64
+	CreatedAt time.Time
+	DeletedAt *time.Time
+	Email     string
+}
+
+type UserRecord struct {
+	ID        int64
+	Name      sql.NullString
+	Nickname  sql.NullString
+	Age       sql.NullInt32
+	Score     sql.Null[float64]
+	CreatedAt sql.NullTime
+	DeletedAt sql.NullTime
+	Email     sql.Null[string]
+}
+
+//go:generate go run github.com/reedom/convergen
+type Convergen interface {
+	// :sqlnull
+	// :typecast
+	UserToRecord(*User) *UserRecord
+	// :sqlnull
+	// :typecast
+	UserFromRecord(*UserRecord) *User
+}
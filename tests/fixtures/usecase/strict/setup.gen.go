@@ -0,0 +1,48 @@
+// Code generated by github.com/reedom/convergen
+// DO NOT EDIT.
+
+package strict
+
+type User struct {
+	ID       int
+	Name     string
+	Password string
+}
+
+type UserModel struct {
+	ID      int
+	Name    string
+	Created int64
+}
+
+type UserView struct {
+	ID    int
+	Name  string
+	Email string
+}
+
+func UserToModel(src *User) (dst *UserModel) {
+	if src == nil {
+		return
+	}
+
+	dst = &UserModel{}
+	dst.ID = src.ID
+	dst.Name = src.Name
+	// skip: dst.Created
+
+	return
+}
+
+func UserToView(src *User) (dst *UserView) {
+	if src == nil {
+		return
+	}
+
+	dst = &UserView{}
+	dst.ID = src.ID
+	dst.Name = src.Name
+	// no match: dst.Email
+
+	return
+}
@@ -0,0 +1,30 @@
+//go:build convergen
+
+package strict
+
+type User struct {
+	ID       int
+	Name     string
+	Password string
+}
+
+type UserModel struct {
+	ID      int
+	Name    string
+	Created int64
+}
+
+type UserView struct {
+	ID    int
+	Name  string
+	Email string
+}
+
+//go:generate go run github.com/reedom/convergen
+// :strict
+type Convergen interface {
+	// :skip Created
+	UserToModel(*User) *UserModel
+	// :strict:off
+	UserToView(*User) *UserView
+}
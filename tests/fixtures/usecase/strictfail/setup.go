@@ -0,0 +1,21 @@
+//go:build convergen
+
+package strictfail
+
+type User struct {
+	ID   int
+	Name string
+}
+
+type UserView struct {
+	ID    int
+	Name  string
+	Email string
+	Phone string
+}
+
+//go:generate go run github.com/reedom/convergen
+// :strict
+type Convergen interface {
+	UserToView(*User) *UserView
+}
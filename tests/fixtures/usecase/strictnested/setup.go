@@ -0,0 +1,28 @@
+//go:build convergen
+
+package strictnested
+
+type Address struct {
+	City string
+}
+
+type User struct {
+	Address Address
+	Name    string
+}
+
+type AddressView struct {
+	City string
+	Zip  string
+}
+
+type UserView struct {
+	Address AddressView
+	Name    string
+}
+
+//go:generate go run github.com/reedom/convergen
+// :strict
+type Convergen interface {
+	UserToView(*User) *UserView
+}
@@ -9,6 +9,10 @@ import (
 )
 
 func LocalToModel(pet *local.Pet) (dst *model.Pet) {
+	if pet == nil {
+		return
+	}
+
 	dst = &model.Pet{}
 	dst.ID = pet.ID
 	// no match: dst.Category
@@ -16,6 +16,10 @@ type Pet struct {
 }
 
 func ArgToArg(dst *model.Pet, pet *Pet) {
+	if pet == nil {
+		return
+	}
+
 	dst.ID = pet.ID
 	dst.Category = pet.Category
 	dst.Name = pet.Name
@@ -27,6 +31,10 @@ func ArgToArg(dst *model.Pet, pet *Pet) {
 }
 
 func ArgToReturn(pet *Pet) (dst *model.Pet) {
+	if pet == nil {
+		return
+	}
+
 	dst = &model.Pet{}
 	dst.ID = pet.ID
 	dst.Category = pet.Category
@@ -41,6 +49,10 @@ func ArgToReturn(pet *Pet) (dst *model.Pet) {
 }
 
 func (r *Pet) RcvToArg(dst *model.Pet) {
+	if r == nil {
+		return
+	}
+
 	dst.ID = r.ID
 	dst.Category = r.Category
 	dst.Name = r.Name
@@ -52,6 +64,10 @@ func (r *Pet) RcvToArg(dst *model.Pet) {
 }
 
 func (r *Pet) RcvToReturn(dst *model.Pet) {
+	if r == nil {
+		return
+	}
+
 	dst.ID = r.ID
 	dst.Category = r.Category
 	dst.Name = r.Name
@@ -63,6 +79,10 @@ func (r *Pet) RcvToReturn(dst *model.Pet) {
 }
 
 func (r *Pet) RevRcvFromArgPtr(pet *model.Pet) {
+	if r == nil {
+		return
+	}
+
 	r.ID = pet.ID
 	r.Category = pet.Category
 	r.Name = pet.Name
@@ -74,6 +94,10 @@ func (r *Pet) RevRcvFromArgPtr(pet *model.Pet) {
 }
 
 func (r *Pet) RevRcvFromArgVal(src *model.Pet) {
+	if r == nil {
+		return
+	}
+
 	r.ID = src.ID
 	r.Category = src.Category
 	r.Name = src.Name
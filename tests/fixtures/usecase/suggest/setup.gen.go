@@ -0,0 +1,32 @@
+// Code generated by github.com/reedom/convergen
+// DO NOT EDIT.
+
+package suggest
+
+type User struct {
+	ID      int64
+	Address string
+	EMail   string
+	Age     int
+}
+
+type UserModel struct {
+	ID     int64
+	Adress string
+	Email  string
+	Age    int64
+}
+
+func UserToModel(src *User) (dst *UserModel) {
+	if src == nil {
+		return
+	}
+
+	dst = &UserModel{}
+	dst.ID = src.ID
+	// no match: dst.Adress
+	// no match: dst.Email
+	// no match: dst.Age
+
+	return
+}
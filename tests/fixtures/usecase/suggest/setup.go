@@ -0,0 +1,23 @@
+//go:build convergen
+
+package suggest
+
+type User struct {
+	ID      int64
+	Address string
+	EMail   string
+	Age     int
+}
+
+type UserModel struct {
+	ID     int64
+	Adress string
+	Email  string
+	Age    int64
+}
+
+//go:generate go run github.com/reedom/convergen
+// :case
+type Convergen interface {
+	UserToModel(*User) *UserModel
+}
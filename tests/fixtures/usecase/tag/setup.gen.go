@@ -0,0 +1,73 @@
+// Code generated by github.com/reedom/convergen
+// DO NOT EDIT.
+
+package tag
+
+type User struct {
+	UserID    int64  `json:"id" db:"user_id"`
+	FirstName string `json:"first_name" db:"first"`
+	Email     string `json:"email"`
+	Password  string `json:"-"`
+	Note      string
+}
+
+type UserRecord struct {
+	ID       int64  `db:"user_id"`
+	First    string `db:"first"`
+	Email    string
+	Password string
+	Note     string
+}
+
+type UserResponse struct {
+	ID       int64  `json:"id"`
+	Name     string `json:"first_name"`
+	Mail     string `json:"email"`
+	Password string `json:"password"`
+	Note     string
+}
+
+func ToRecord(src *User) (dst *UserRecord) {
+	if src == nil {
+		return
+	}
+
+	dst = &UserRecord{}
+	dst.ID = src.UserID
+	dst.First = src.FirstName
+	// no match: dst.Email
+	// no match: dst.Password
+	// no match: dst.Note
+
+	return
+}
+
+func ToRecordWithFallback(src *User) (dst *UserRecord) {
+	if src == nil {
+		return
+	}
+
+	dst = &UserRecord{}
+	dst.ID = src.UserID
+	dst.First = src.FirstName
+	dst.Email = src.Email
+	dst.Password = src.Password
+	dst.Note = src.Note
+
+	return
+}
+
+func ToResponse(src *User) (dst *UserResponse) {
+	if src == nil {
+		return
+	}
+
+	dst = &UserResponse{}
+	dst.ID = src.UserID
+	dst.Name = src.FirstName
+	dst.Mail = src.Email
+	// no match: dst.Password
+	// no match: dst.Note
+
+	return
+}
@@ -0,0 +1,39 @@
+//go:build convergen
+
+package tag
+
+type User struct {
+	UserID    int64  `json:"id" db:"user_id"`
+	FirstName string `json:"first_name" db:"first"`
+	Email     string `json:"email"`
+	Password  string `json:"-"`
+	Note      string
+}
+
+type UserRecord struct {
+	ID       int64  `db:"user_id"`
+	First    string `db:"first"`
+	Email    string
+	Password string
+	Note     string
+}
+
+type UserResponse struct {
+	ID       int64  `json:"id"`
+	Name     string `json:"first_name"`
+	Mail     string `json:"email"`
+	Password string `json:"password"`
+	Note     string
+}
+
+//go:generate go run github.com/reedom/convergen
+type Convergen interface {
+	// :match tag db
+	ToRecord(*User) *UserRecord
+
+	// :match tag db name
+	ToRecordWithFallback(*User) *UserRecord
+
+	// :match tag
+	ToResponse(*User) *UserResponse
+}
@@ -0,0 +1,111 @@
+// Code generated by github.com/reedom/convergen
+// DO NOT EDIT.
+
+package timeconv
+
+import (
+	"time"
+)
+
+type Event struct {
+	ID        int
+	CreatedAt time.Time
+	UpdatedAt *time.Time
+	Timeout   time.Duration
+	Interval  time.Duration
+}
+
+type EventModel struct {
+	ID        int
+	CreatedAt int64
+	UpdatedAt int64
+	Timeout   int64
+	Interval  string
+}
+
+type EventView struct {
+	ID        int
+	CreatedAt string
+	UpdatedAt *string
+	Timeout   string
+}
+
+func EventFromModel(src *EventModel) (dst *Event, err error) {
+	if src == nil {
+		return
+	}
+
+	dst = &Event{}
+	dst.ID = src.ID
+	dst.CreatedAt = time.UnixMilli(src.CreatedAt)
+	dst.UpdatedAt = new(time.Time)
+	*dst.UpdatedAt = time.UnixMilli(src.UpdatedAt)
+	dst.Timeout = time.Duration(src.Timeout) * time.Millisecond
+	dst.Interval, err = time.ParseDuration(src.Interval)
+	if err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+func EventFromView(src *EventView) (dst *Event, err error) {
+	if src == nil {
+		return
+	}
+
+	dst = &Event{}
+	dst.ID = src.ID
+	dst.CreatedAt, err = time.Parse("2006-01-02 15:04:05", src.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	if src.UpdatedAt != nil {
+		dst.UpdatedAt = new(time.Time)
+		*dst.UpdatedAt, err = time.Parse("2006-01-02 15:04:05", *src.UpdatedAt)
+	}
+	if err != nil {
+		return nil, err
+	}
+	dst.Timeout, err = time.ParseDuration(src.Timeout)
+	if err != nil {
+		return nil, err
+	}
+	// skip: dst.Interval
+
+	return
+}
+
+func EventToModel(src *Event) (dst *EventModel) {
+	if src == nil {
+		return
+	}
+
+	dst = &EventModel{}
+	dst.ID = src.ID
+	dst.CreatedAt = src.CreatedAt.UnixMilli()
+	if src.UpdatedAt != nil {
+		dst.UpdatedAt = (*src.UpdatedAt).UnixMilli()
+	}
+	dst.Timeout = src.Timeout.Milliseconds()
+	dst.Interval = src.Interval.String()
+
+	return
+}
+
+func EventToView(src *Event) (dst *EventView) {
+	if src == nil {
+		return
+	}
+
+	dst = &EventView{}
+	dst.ID = src.ID
+	dst.CreatedAt = src.CreatedAt.Format(time.RFC3339)
+	if src.UpdatedAt != nil {
+		dst.UpdatedAt = new(string)
+		*dst.UpdatedAt = (*src.UpdatedAt).Format(time.RFC3339)
+	}
+	dst.Timeout = src.Timeout.String()
+
+	return
+}
@@ -0,0 +1,43 @@
+//go:build convergen
+
+package timeconv
+
+import (
+	"time"
+)
+
+type Event struct {
+	ID        int
+	CreatedAt time.Time
+	UpdatedAt *time.Time
+	Timeout   time.Duration
+	Interval  time.Duration
+}
+
+type EventModel struct {
+	ID        int
+	CreatedAt int64
+	UpdatedAt int64
+	Timeout   int64
+	Interval  string
+}
+
+type EventView struct {
+	ID        int
+	CreatedAt string
+	UpdatedAt *string
+	Timeout   string
+}
+
+//go:generate go run github.com/reedom/convergen
+type Convergen interface {
+	// :time unixmilli
+	EventToModel(*Event) *EventModel
+	// :time unixmilli
+	EventFromModel(*EventModel) (*Event, error)
+	// :time rfc3339
+	EventToView(*Event) *EventView
+	// :time layout:2006-01-02 15:04:05
+	// :skip Interval
+	EventFromView(*EventView) (*Event, error)
+}
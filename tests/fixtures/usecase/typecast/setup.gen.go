@@ -14,6 +14,10 @@ import (
 // - int64 -> int
 // - enums.Status -> string
 func DomainToModel(src *domain.User) (dst *model.User) {
+	if src == nil {
+		return
+	}
+
 	dst = &model.User{}
 	dst.ID = int64(src.ID)
 	dst.Name = src.Name
@@ -28,6 +32,10 @@ func DomainToModel(src *domain.User) (dst *model.User) {
 //   - string -> enums.Status
 //     "enums" package will be imported automatically in the generated code!
 func ModelToDomain(src *model.User) (dst *domain.User) {
+	if src == nil {
+		return
+	}
+
 	dst = &domain.User{}
 	dst.ID = int(src.ID)
 	dst.Name = src.Name
@@ -1,6 +1,7 @@
 package testing
 
 import (
+	"bytes"
 	"fmt"
 	"os"
 	"testing"
@@ -20,6 +21,7 @@ func TestUseCases(t *testing.T) {
 	cases := []struct {
 		source   string
 		expected string
+		project  string
 	}{
 		{
 			source:   "fixtures/usecase/converter/setup.go",
@@ -81,6 +83,120 @@ func TestUseCases(t *testing.T) {
 			source:   "fixtures/usecase/maps/setup.go",
 			expected: "fixtures/usecase/maps/setup.gen.go",
 		},
+		{
+			source:   "fixtures/usecase/tag/setup.go",
+			expected: "fixtures/usecase/tag/setup.gen.go",
+		},
+		{
+			source:   "fixtures/usecase/convtype/setup.go",
+			expected: "fixtures/usecase/convtype/setup.gen.go",
+		},
+		{
+			source:   "fixtures/usecase/convwith/setup.go",
+			expected: "fixtures/usecase/convwith/setup.gen.go",
+		},
+		{
+			source:   "fixtures/usecase/multisrc/setup.go",
+			expected: "fixtures/usecase/multisrc/setup.gen.go",
+		},
+		{
+			source:   "fixtures/usecase/ctxarg/setup.go",
+			expected: "fixtures/usecase/ctxarg/setup.gen.go",
+		},
+		{
+			source:   "fixtures/usecase/intfrules/setup.go",
+			expected: "fixtures/usecase/intfrules/setup.gen.go",
+		},
+		{
+			source:   "fixtures/usecase/preset/setup.go",
+			expected: "fixtures/usecase/preset/setup.gen.go",
+		},
+		{
+			source:   "fixtures/usecase/generics/setup.go",
+			expected: "fixtures/usecase/generics/setup.gen.go",
+		},
+		{
+			source:   "fixtures/usecase/project/setup.go",
+			expected: "fixtures/usecase/project/setup.gen.go",
+			project:  "fixtures/usecase/project/convergen.yaml",
+		},
+		{
+			source:   "fixtures/usecase/projectintf/setup.go",
+			expected: "fixtures/usecase/projectintf/setup.gen.go",
+			project:  "fixtures/usecase/projectintf/convergen.yaml",
+		},
+		{
+			source:   "fixtures/usecase/fieldtag/setup.go",
+			expected: "fixtures/usecase/fieldtag/setup.gen.go",
+		},
+		{
+			source:   "fixtures/usecase/rename/setup.go",
+			expected: "fixtures/usecase/rename/setup.gen.go",
+		},
+		{
+			source:   "fixtures/usecase/normalized/setup.go",
+			expected: "fixtures/usecase/normalized/setup.gen.go",
+		},
+		{
+			source:   "fixtures/usecase/strict/setup.go",
+			expected: "fixtures/usecase/strict/setup.gen.go",
+		},
+		{
+			source:   "fixtures/usecase/exhaustive/setup.go",
+			expected: "fixtures/usecase/exhaustive/setup.gen.go",
+		},
+		{
+			source:   "fixtures/usecase/exhaustivedrop/setup.go",
+			expected: "fixtures/usecase/exhaustivedrop/setup.gen.go",
+		},
+		{
+			source:   "fixtures/usecase/pointer/setup.go",
+			expected: "fixtures/usecase/pointer/setup.gen.go",
+		},
+		{
+			source:   "fixtures/usecase/mapcopy/setup.go",
+			expected: "fixtures/usecase/mapcopy/setup.gen.go",
+		},
+		{
+			source:   "fixtures/usecase/recursive/setup.go",
+			expected: "fixtures/usecase/recursive/setup.gen.go",
+		},
+		{
+			source:   "fixtures/usecase/array/setup.go",
+			expected: "fixtures/usecase/array/setup.gen.go",
+		},
+		{
+			source:   "fixtures/usecase/sliceelem/setup.go",
+			expected: "fixtures/usecase/sliceelem/setup.gen.go",
+		},
+		{
+			source:   "fixtures/usecase/siblingerr/setup.go",
+			expected: "fixtures/usecase/siblingerr/setup.gen.go",
+		},
+		{
+			source:   "fixtures/usecase/sqlnull/setup.go",
+			expected: "fixtures/usecase/sqlnull/setup.gen.go",
+		},
+		{
+			source:   "fixtures/usecase/timeconv/setup.go",
+			expected: "fixtures/usecase/timeconv/setup.gen.go",
+		},
+		{
+			source:   "fixtures/usecase/protomsg/setup.go",
+			expected: "fixtures/usecase/protomsg/setup.gen.go",
+		},
+		{
+			source:   "fixtures/usecase/enumstr/setup.go",
+			expected: "fixtures/usecase/enumstr/setup.gen.go",
+		},
+		{
+			source:   "fixtures/usecase/enummap/setup.go",
+			expected: "fixtures/usecase/enummap/setup.gen.go",
+		},
+		{
+			source:   "fixtures/usecase/suggest/setup.go",
+			expected: "fixtures/usecase/suggest/setup.gen.go",
+		},
 	}
 
 	logger.SetupLogger(logger.ForTest())
@@ -95,10 +211,14 @@ func TestUseCases(t *testing.T) {
 			//log.SetFlags(log.Llongfile)
 			//logger.SetupLogger(logger.Enable())
 
-			p, err := parser.NewParser(&config.Config{
+			conf := &config.Config{
 				Input:  tt.source,
 				Output: tt.expected,
-			})
+			}
+			if tt.project != "" {
+				require.Nil(t, conf.LoadFile(tt.project))
+			}
+			p, err := parser.NewParser(conf)
 			require.Nil(t, err)
 			methods, err := p.Parse()
 			require.Nil(t, err)
@@ -118,6 +238,7 @@ func TestUseCases(t *testing.T) {
 			baseCode, err := p.GenerateBaseCode()
 			require.Nil(t, err)
 			code := model.Code{
+				Header:         conf.Header,
 				BaseCode:       baseCode,
 				FunctionBlocks: funcBlocks,
 			}
@@ -133,3 +254,131 @@ func TestUseCases(t *testing.T) {
 		})
 	}
 }
+
+func TestStrictMode(t *testing.T) {
+	logger.SetupLogger(logger.ForTest())
+
+	conf := &config.Config{
+		Input:  "fixtures/usecase/simple/setup.go",
+		Output: "fixtures/usecase/simple/setup.gen.go",
+		Strict: true,
+	}
+	p, err := parser.NewParser(conf)
+	require.Nil(t, err)
+	methods, err := p.Parse()
+	require.Nil(t, err)
+
+	builder := p.CreateBuilder()
+	_, err = builder.CreateFunctions(methods[0].Methods)
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "no assignment for dst.")
+}
+
+// buildFixture parses the setup file and builds the functions of its first interface.
+// It returns the log messages written meanwhile, including the warnings, along with the error.
+func buildFixture(t *testing.T, conf *config.Config) (string, error) {
+	t.Helper()
+	var log bytes.Buffer
+	logger.SetupLogger(logger.Enable(), logger.Output(&log), logger.ForTest())
+	defer logger.SetupLogger(logger.ForTest())
+
+	p, err := parser.NewParser(conf)
+	require.Nil(t, err)
+	methods, err := p.Parse()
+	if err != nil {
+		return log.String(), err
+	}
+
+	builder := p.CreateBuilder()
+	_, err = builder.CreateFunctions(methods[0].Methods)
+	return log.String(), err
+}
+
+func TestAmbiguousArgs(t *testing.T) {
+	_, err := buildFixture(t, &config.Config{
+		Input:  "fixtures/usecase/ambiguousarg/setup.go",
+		Output: "fixtures/usecase/ambiguousarg/setup.gen.go",
+	})
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "ambiguous args lang, region for param locale string")
+}
+
+func TestSuggestions(t *testing.T) {
+	log, err := buildFixture(t, &config.Config{
+		Input:  "fixtures/usecase/suggest/setup.go",
+		Output: "fixtures/usecase/suggest/setup.gen.go",
+	})
+	require.Nil(t, err)
+	assert.Contains(t, log, "no assignment for dst.Adress [string], did you mean src.Address (similar name)?")
+	assert.Contains(t, log, "no assignment for dst.Email [string], did you mean src.EMail (differs in case; use :case:off)?")
+	assert.Contains(t, log, "no assignment for dst.Age [int64], did you mean src.Age (int is not assignable to int64; use :typecast)?")
+}
+
+func TestUnusedSources(t *testing.T) {
+	log, err := buildFixture(t, &config.Config{
+		Input:  "fixtures/usecase/exhaustivedrop/setup.go",
+		Output: "fixtures/usecase/exhaustivedrop/setup.gen.go",
+	})
+	require.Nil(t, err)
+	assert.Contains(t, log, "src.Age is not used")
+	assert.Contains(t, log, "src.Email is not used")
+	assert.NotContains(t, log, "src.Name is not used")
+}
+
+func TestUnusedSourcesStrictMode(t *testing.T) {
+	_, err := buildFixture(t, &config.Config{
+		Input:  "fixtures/usecase/exhaustivedrop/setup.go",
+		Output: "fixtures/usecase/exhaustivedrop/setup.gen.go",
+		Strict: true,
+	})
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "src.Age is not used in strict mode, use :ignore:src to drop it")
+	assert.Contains(t, err.Error(), "src.Email is not used in strict mode, use :ignore:src to drop it")
+	assert.NotContains(t, err.Error(), "src.Name is not used")
+}
+
+func TestArrayLengthCheck(t *testing.T) {
+	log, err := buildFixture(t, &config.Config{
+		Input:  "fixtures/usecase/array/setup.go",
+		Output: "fixtures/usecase/array/setup.gen.go",
+	})
+	require.Nil(t, err)
+	assert.Contains(t, log, "copying src.Tags to the array dst.Tags needs the method to return an error for the length check")
+}
+
+func TestEnumMissingCounterpart(t *testing.T) {
+	_, err := buildFixture(t, &config.Config{
+		Input:  "fixtures/usecase/enumextra/setup.go",
+		Output: "fixtures/usecase/enumextra/setup.gen.go",
+	})
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "StatusArchived has no counterpart in PbStatus")
+}
+
+func TestEnumAmbiguousPairing(t *testing.T) {
+	_, err := buildFixture(t, &config.Config{
+		Input:  "fixtures/usecase/enumcollide/setup.go",
+		Output: "fixtures/usecase/enumcollide/setup.gen.go",
+	})
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), `StatusActive and Status_ACTIVE are both paired with "active"`)
+}
+
+func TestStrictModeFieldPositions(t *testing.T) {
+	_, err := buildFixture(t, &config.Config{
+		Input:  "fixtures/usecase/strictfail/setup.go",
+		Output: "fixtures/usecase/strictfail/setup.gen.go",
+	})
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "strictfail/setup.go:13:2: no assignment for dst.Email in UserToView in strict mode")
+	assert.Contains(t, err.Error(), "strictfail/setup.go:14:2: no assignment for dst.Phone in UserToView in strict mode")
+}
+
+func TestStrictModeNestedField(t *testing.T) {
+	_, err := buildFixture(t, &config.Config{
+		Input:  "fixtures/usecase/strictnested/setup.go",
+		Output: "fixtures/usecase/strictnested/setup.gen.go",
+	})
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "strictnested/setup.go:16:2: no assignment for dst.Zip in addressToAddressView in strict mode")
+}